@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS board_columns (
+    id SERIAL PRIMARY KEY,
+    board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    -- DEFERRABLE: при сдвиге позиций дубликаты допустимы внутри транзакции, проверка на COMMIT
+    CONSTRAINT board_columns_board_id_position_key UNIQUE (board_id, position) DEFERRABLE INITIALLY DEFERRED
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS tasks (
+    id SERIAL PRIMARY KEY,
+    column_id INTEGER NOT NULL REFERENCES board_columns(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT,
+    assignee_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT tasks_column_id_position_key UNIQUE (column_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE INDEX IF NOT EXISTS tasks_assignee_id_idx ON tasks (assignee_id);
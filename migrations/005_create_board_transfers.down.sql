@@ -0,0 +1 @@
+DROP TABLE IF EXISTS board_transfers;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- История передачи досок между владельцами
+CREATE TABLE IF NOT EXISTS board_transfers (
+    id SERIAL PRIMARY KEY,
+    board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    from_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transferred_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transferred_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS board_transfers_board_id_idx ON board_transfers (board_id);
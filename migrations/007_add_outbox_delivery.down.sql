@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS outbox_events_pending_board_idx;
+DROP INDEX IF EXISTS outbox_events_pending_idx;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS sent_at;
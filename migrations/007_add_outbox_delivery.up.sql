@@ -0,0 +1,10 @@
+-- Состояние доставки событий из outbox в Kafka
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS sent_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_error TEXT;
+
+-- Релей читает только неотправленные события, по порядку и по доске
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events (id) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_events_pending_board_idx ON outbox_events (board_id, id) WHERE sent_at IS NULL;
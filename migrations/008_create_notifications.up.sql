@@ -0,0 +1,22 @@
+-- История "отправленных" уведомлений Notification Service
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL,
+    user_id INTEGER NOT NULL,
+    board_id INTEGER NOT NULL,
+    task_id INTEGER,
+    event_type TEXT NOT NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    -- Одно событие дает не больше одного уведомления каждому получателю
+    UNIQUE (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id, id DESC);
+
+-- Обработанные события: повторная доставка из Kafka по ним отбрасывается
+CREATE TABLE IF NOT EXISTS processed_events (
+    event_id UUID PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
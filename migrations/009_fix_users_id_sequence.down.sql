@@ -0,0 +1,2 @@
+-- Откатывать нечего: значение последовательности не влияет на схему
+SELECT 1;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- 001 вставляет тестового пользователя с явным id = 1, и последовательность
+-- об этом не знает: первая регистрация упала бы на users_pkey. Сдвигаем её за max(id).
+SELECT setval(pg_get_serial_sequence('users', 'id'), GREATEST((SELECT MAX(id) FROM users), 1));
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS board_members;
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- Участники доски. Владелец тоже хранится здесь с ролью owner
+CREATE TABLE IF NOT EXISTS board_members (
+    board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'editor', 'viewer')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (board_id, user_id)
+);
+
+-- Доски пользователя ищутся по user_id
+CREATE INDEX IF NOT EXISTS board_members_user_id_idx ON board_members (user_id);
+
+-- У существующих досок владелец становится участником
+INSERT INTO board_members (board_id, user_id, role)
+SELECT id, user_id, 'owner' FROM boards
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,18 @@
+-- Приглашения на доску по email. Храним только sha256 токена
+CREATE TABLE IF NOT EXISTS board_invitations (
+    id SERIAL PRIMARY KEY,
+    board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'editor', 'viewer')),
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    accepted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Список действующих приглашений доски
+CREATE INDEX IF NOT EXISTS board_invitations_board_id_idx ON board_invitations (board_id)
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
@@ -0,0 +1,2 @@
+-- Версия доски для оптимистичной блокировки: растет при каждом UPDATE
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
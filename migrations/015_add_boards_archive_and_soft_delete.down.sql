@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS boards_deleted_at_idx;
+ALTER TABLE boards DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE boards DROP COLUMN IF EXISTS archived_at;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- Архив и мягкое удаление досок: NULL — доска активна / не удалена
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Для фоновой очистки удаленных досок
+CREATE INDEX IF NOT EXISTS boards_deleted_at_idx ON boards (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS boards_is_template_idx;
+ALTER TABLE boards DROP COLUMN IF EXISTS is_template;
\ No newline at end of file
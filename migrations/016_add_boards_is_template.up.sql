@@ -0,0 +1,4 @@
+-- Доски-шаблоны, из которых клонированием создаются новые
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS is_template BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS boards_is_template_idx ON boards (id) WHERE is_template;
@@ -0,0 +1,54 @@
+// Package etag — версия доски в заголовках ETag и If-Match. Общий для API Gateway
+// и HTTP API Board Service, чтобы оба понимали заголовки одинаково.
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
+// Format возвращает версию сильным ETag: "3"
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// SetVersion отдает версию доски в заголовке ETag
+func SetVersion(c *fiber.Ctx, version int64) {
+	c.Set(fiber.HeaderETag, Format(version))
+}
+
+// ParseIfMatch разбирает значение If-Match в ожидаемую версию доски.
+// Пустое значение и "*" дают nil — обновление без проверки.
+// Слабые и составные значения не поддерживаются: версия у доски одна.
+func ParseIfMatch(header string) (*int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(header, `"`) {
+		return nil, ErrInvalidIfMatch
+	}
+
+	raw, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, ErrInvalidIfMatch
+	}
+
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidIfMatch
+	}
+
+	return &version, nil
+}
+
+// ExpectedVersion читает ожидаемую версию доски из заголовка If-Match запроса
+func ExpectedVersion(c *fiber.Ctx) (*int64, error) {
+	return ParseIfMatch(c.Get(fiber.HeaderIfMatch))
+}
@@ -0,0 +1,66 @@
+package etag
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	version := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name    string
+		header  string
+		want    *int64
+		wantErr bool
+	}{
+		{name: "empty", header: "", want: nil},
+		{name: "spaces only", header: "   ", want: nil},
+		{name: "any version", header: "*", want: nil},
+		{name: "strong tag", header: `"3"`, want: version(3)},
+		{name: "strong tag with spaces", header: ` "42" `, want: version(42)},
+		{name: "zero version", header: `"0"`, want: version(0)},
+		{name: "weak tag", header: `W/"3"`, wantErr: true},
+		{name: "list of tags", header: `"3", "4"`, wantErr: true},
+		{name: "unquoted", header: "3", wantErr: true},
+		{name: "unterminated quote", header: `"3`, wantErr: true},
+		{name: "backquoted", header: "`3`", wantErr: true},
+		{name: "not a number", header: `"abc"`, wantErr: true},
+		{name: "empty tag", header: `""`, wantErr: true},
+		{name: "overflow", header: `"99999999999999999999"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIfMatch(tt.header)
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidIfMatch) {
+					t.Fatalf("err = %v, want ErrInvalidIfMatch", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+
+			switch {
+			case tt.want == nil && got != nil:
+				t.Fatalf("version = %d, want nil", *got)
+			case tt.want != nil && got == nil:
+				t.Fatalf("version = nil, want %d", *tt.want)
+			case tt.want != nil && *got != *tt.want:
+				t.Fatalf("version = %d, want %d", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	for _, v := range []int64{0, 1, 17, 1 << 40} {
+		got, err := ParseIfMatch(Format(v))
+		if err != nil || got == nil || *got != v {
+			t.Fatalf("ParseIfMatch(Format(%d)) = %v, %v", v, got, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Package jwtauth проверяет access токены Auth Service. Общий для API Gateway
+// и HTTP API Board Service, чтобы оба принимали одни и те же токены.
+package jwtauth
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrMissingToken   = errors.New("missing bearer token")
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrInvalidSubject = errors.New("invalid token subject")
+)
+
+// Verifier принимает только действующие access токены Auth Service:
+// подпись HS256, наш issuer, не истек. Subject — id пользователя.
+type Verifier struct {
+	parser *jwt.Parser
+	key    []byte
+}
+
+func NewVerifier(secret, issuer string) *Verifier {
+	return &Verifier{
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithIssuer(issuer),
+			jwt.WithExpirationRequired(),
+		),
+		key: []byte(secret),
+	}
+}
+
+// UserID проверяет значение заголовка Authorization и возвращает id пользователя
+func (v *Verifier) UserID(header string) (int64, error) {
+	raw, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || raw == "" {
+		return 0, ErrMissingToken
+	}
+
+	var claims jwt.RegisteredClaims
+	if _, err := v.parser.ParseWithClaims(raw, &claims, func(*jwt.Token) (any, error) { return v.key, nil }); err != nil {
+		return 0, ErrInvalidToken
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, ErrInvalidSubject
+	}
+
+	return userID, nil
+}
+
+// Middleware пропускает запрос, только если в заголовке Authorization есть действующий
+// access токен. Куда положить id пользователя, решает сервис в authenticated.
+func Middleware(secret, issuer string, authenticated func(c *fiber.Ctx, userID int64)) fiber.Handler {
+	verifier := NewVerifier(secret, issuer)
+
+	return func(c *fiber.Ctx) error {
+		userID, err := verifier.UserID(c.Get(fiber.HeaderAuthorization))
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		authenticated(c, userID)
+
+		return c.Next()
+	}
+}
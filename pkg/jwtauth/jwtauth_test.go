@@ -0,0 +1,115 @@
+package jwtauth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testSecret = "test-secret"
+	testIssuer = "taskify-auth"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	signed, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signed
+}
+
+func TestVerifierUserID(t *testing.T) {
+	now := time.Now()
+	valid := jwt.RegisteredClaims{
+		Subject:   "42",
+		Issuer:    testIssuer,
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+	}
+	with := func(change func(c *jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		c := valid
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		want   int64
+		err    error
+	}{
+		{name: "valid token", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, valid), want: 42},
+		{name: "no header", header: "", err: ErrMissingToken},
+		{name: "not a bearer token", header: "Basic dXNlcjpwYXNz", err: ErrMissingToken},
+		{name: "empty bearer token", header: "Bearer ", err: ErrMissingToken},
+		{name: "garbage", header: "Bearer abc.def.ghi", err: ErrInvalidToken},
+		{name: "another secret", header: "Bearer " + sign(t, jwt.SigningMethodHS256, "other", valid), err: ErrInvalidToken},
+		{name: "another algorithm", header: "Bearer " + sign(t, jwt.SigningMethodHS512, testSecret, valid), err: ErrInvalidToken},
+		{name: "another issuer", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, with(func(c *jwt.RegisteredClaims) { c.Issuer = "other" })), err: ErrInvalidToken},
+		{name: "expired", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, with(func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute)) })), err: ErrInvalidToken},
+		{name: "without expiration", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, with(func(c *jwt.RegisteredClaims) { c.ExpiresAt = nil })), err: ErrInvalidToken},
+		{name: "non-numeric subject", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, with(func(c *jwt.RegisteredClaims) { c.Subject = "bob" })), err: ErrInvalidSubject},
+		{name: "zero subject", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, with(func(c *jwt.RegisteredClaims) { c.Subject = "0" })), err: ErrInvalidSubject},
+	}
+
+	verifier := NewVerifier(testSecret, testIssuer)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.UserID(tt.header)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("UserID = %d, %v, want %d, %v", got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	valid := sign(t, jwt.SigningMethodHS256, testSecret, jwt.RegisteredClaims{
+		Subject:   "42",
+		Issuer:    testIssuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		status int
+		// userID — что получил authenticated; 0 — не вызывался
+		userID int64
+	}{
+		{name: "valid token", header: "Bearer " + valid, status: fiber.StatusOK, userID: 42},
+		{name: "no header", status: fiber.StatusUnauthorized},
+		{name: "invalid token", header: "Bearer abc.def.ghi", status: fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var userID int64
+			app := fiber.New()
+			app.Get("/", Middleware(testSecret, testIssuer, func(_ *fiber.Ctx, id int64) { userID = id }), func(c *fiber.Ctx) error {
+				return c.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(fiber.HeaderAuthorization, tt.header)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status || userID != tt.userID {
+				t.Errorf("status = %d, user = %d, want %d, %d", resp.StatusCode, userID, tt.status, tt.userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+//swag init -g services/api-gateway/cmd/main.go --output services/api-gateway/docs
+
+import (
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/swagger"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	_ "Taskify/services/api-gateway/docs"
+
+	boardspb "Taskify/proto/boards/v1"
+	userspb "Taskify/proto/users/v1"
+
+	"Taskify/services/api-gateway/internal/config"
+	"Taskify/services/api-gateway/internal/transport/http/middleware"
+	httpHandler "Taskify/services/api-gateway/internal/transport/http/v1"
+)
+
+// @title           Taskify API Gateway
+// @version         1.0
+// @description     Public REST API of Taskify. Requests are routed to internal services over gRPC.
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+
+// @BasePath        /v1
+func main() {
+	serviceConfig := config.MustLoad()
+
+	setupLogger(serviceConfig.Env)
+
+	// 1. gRPC клиенты. Соединения ленивые: шлюз стартует, даже если сервисы еще не поднялись
+	authConn, err := grpc.NewClient(serviceConfig.Services.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create auth service client")
+	}
+	defer authConn.Close()
+
+	boardConn, err := grpc.NewClient(serviceConfig.Services.BoardAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create board service client")
+	}
+	defer boardConn.Close()
+
+	userClient := userspb.NewUserServiceClient(authConn)
+	boardClient := boardspb.NewBoardServiceClient(boardConn)
+
+	// 2. HTTP
+	app := fiber.New()
+	v1 := app.Group("/v1")
+
+	authMiddleware := middleware.JWTAuth(serviceConfig.JWT.Secret, serviceConfig.JWT.Issuer)
+	timeout := serviceConfig.Services.Timeout
+
+	// Auth сам решает, какие маршруты публичные
+	httpHandler.NewAuthHandler(v1, userClient, timeout, authMiddleware)
+
+	// Всё остальное — только с действующим access токеном. Middleware висит на /v1,
+	// поэтому маршруты auth должны быть зарегистрированы раньше: Fiber матчит по порядку.
+	protected := v1.Group("", authMiddleware)
+	httpHandler.NewBoardHandler(protected, boardClient, timeout)
+	httpHandler.NewBoardStateHandler(protected, boardClient, timeout)
+	httpHandler.NewTemplateHandler(protected, boardClient, timeout)
+	httpHandler.NewImportHandler(protected, boardClient, timeout)
+	httpHandler.NewExportHandler(protected, boardClient, timeout)
+	httpHandler.NewColumnHandler(protected, boardClient, timeout)
+	httpHandler.NewTaskHandler(protected, boardClient, timeout)
+	httpHandler.NewMemberHandler(protected, boardClient, timeout)
+	httpHandler.NewInvitationHandler(protected, boardClient, timeout)
+
+	app.Get("/swagger/*", swagger.HandlerDefault)
+
+	log.Printf("API Gateway is running on port %s", serviceConfig.HTTP.Port)
+	if err := app.Listen(serviceConfig.HTTP.Port); err != nil {
+		log.Fatal().Err(err).Msg("Failed to serve HTTP")
+	}
+}
+
+func setupLogger(env string) {
+	switch env {
+	case "local":
+		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "15:04:05"})
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "dev":
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "prod":
+		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type Config struct {
+	Env      string `yaml:"env" env:"ENV" env-default:"local"` // local, dev, prod
+	HTTP     HTTPConfig
+	JWT      JWTConfig
+	Services ServicesConfig
+}
+
+type HTTPConfig struct {
+	Port string `env:"HTTP_PORT" env-default:":8080"`
+}
+
+type JWTConfig struct {
+	// Тот же секрет, которым Auth Service подписывает access токены
+	Secret string `env:"JWT_SECRET" env-required:"true"`
+	Issuer string `env:"JWT_ISSUER" env-default:"taskify-auth"`
+}
+
+// ServicesConfig — адреса gRPC сервисов, в которые проксируются запросы
+type ServicesConfig struct {
+	AuthAddr  string `env:"AUTH_SERVICE_ADDR" env-default:"localhost:50053"`
+	BoardAddr string `env:"BOARD_SERVICE_ADDR" env-default:"localhost:50051"`
+	// Дедлайн одного вызова gRPC
+	Timeout time.Duration `env:"GRPC_TIMEOUT" env-default:"5s"`
+}
+
+func MustLoad() *Config {
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "./config/gateway.env" // Дефолт для локальной разработки
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		log.Printf("Config file %s not found, reading from ENV variables", configPath)
+	}
+
+	var cfg Config
+	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
+		if err := cleanenv.ReadEnv(&cfg); err != nil {
+			log.Fatalf("cannot read config: %s", err)
+		}
+	}
+
+	return &cfg
+}
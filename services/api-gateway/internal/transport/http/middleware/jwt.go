@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/jwtauth"
+)
+
+const (
+	// userIDKey — ключ Fiber locals, под которым лежит id аутентифицированного пользователя
+	userIDKey = "userID"
+	// UserIDMetadataKey — ключ gRPC metadata, в котором id пользователя передается сервисам
+	UserIDMetadataKey = "x-user-id"
+	// IdempotencyMetadataKey — ключ gRPC metadata, в который переносится заголовок Idempotency-Key
+	IdempotencyMetadataKey = "idempotency-key"
+)
+
+// JWTAuth пропускает запрос, только если в нем действующий access токен Auth Service,
+// и кладет id пользователя в Fiber locals
+func JWTAuth(secret, issuer string) fiber.Handler {
+	return jwtauth.Middleware(secret, issuer, func(c *fiber.Ctx, userID int64) {
+		c.Locals(userIDKey, userID)
+	})
+}
+
+// UserID возвращает id пользователя, положенный JWTAuth
+func UserID(c *fiber.Ctx) (int64, bool) {
+	userID, ok := c.Locals(userIDKey).(int64)
+	return userID, ok
+}
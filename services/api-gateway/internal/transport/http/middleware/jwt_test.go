@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testSecret = "test-secret"
+	testIssuer = "taskify-auth"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	signed, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signed
+}
+
+func TestJWTAuth(t *testing.T) {
+	now := time.Now()
+	valid := jwt.RegisteredClaims{
+		Subject:   "42",
+		Issuer:    testIssuer,
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+	}
+
+	// Разбор самих токенов проверяется в pkg/jwtauth, здесь — только адаптер сервиса
+	tests := []struct {
+		name   string
+		header string
+		status int
+	}{
+		{name: "valid token", header: "Bearer " + sign(t, jwt.SigningMethodHS256, testSecret, valid), status: fiber.StatusOK},
+		{name: "no header", header: "", status: fiber.StatusUnauthorized},
+		{name: "another secret", header: "Bearer " + sign(t, jwt.SigningMethodHS256, "other", valid), status: fiber.StatusUnauthorized},
+	}
+
+	app := fiber.New()
+	app.Get("/", JWTAuth(testSecret, testIssuer), func(c *fiber.Ctx) error {
+		userID, ok := UserID(c)
+		if !ok {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+		return c.SendString(strconv.FormatInt(userID, 10))
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(fiber.HeaderAuthorization, tt.header)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+			if tt.status == fiber.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != "42" {
+					t.Errorf("user id = %s, want 42", body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	userspb "Taskify/proto/users/v1"
+
+	"Taskify/services/api-gateway/internal/transport/http/middleware"
+)
+
+type AuthHandler struct {
+	client  userspb.UserServiceClient
+	timeout time.Duration
+}
+
+// NewAuthHandler регистрирует маршруты /auth. Регистрация, вход, обновление и выход
+// по refresh токену публичные, выход из всех сессий требует access токен.
+func NewAuthHandler(api fiber.Router, client userspb.UserServiceClient, timeout time.Duration, authMiddleware fiber.Handler) {
+	handler := &AuthHandler{client: client, timeout: timeout}
+
+	auth := api.Group("/auth")
+	auth.Post("/register", handler.register)
+	auth.Post("/login", handler.login)
+	auth.Post("/refresh", handler.refresh)
+	auth.Post("/logout", handler.logout)
+	auth.Post("/logout-all", authMiddleware, handler.logoutAll)
+}
+
+// @Summary Register a user
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body RegisterRequest true "Credentials"
+// @Success 201 {object} UserResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /auth/register [post]
+func (h *AuthHandler) register(c *fiber.Ctx) error {
+	var req RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.Register(ctx, &userspb.RegisterRequest{
+		Email:    req.Email,
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toUserResponse(resp.GetUser()))
+}
+
+// @Summary Log in
+// @Description Open a session: short-lived access token and single-use refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body LoginRequest true "Credentials"
+// @Success 200 {object} LoginResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /auth/login [post]
+func (h *AuthHandler) login(c *fiber.Ctx) error {
+	var req LoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.Login(ctx, &userspb.LoginRequest{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(LoginResponse{
+		User: toUserResponse(resp.GetUser()),
+		TokensResponse: TokensResponse{
+			AccessToken:           resp.GetAccessToken(),
+			AccessTokenExpiresAt:  resp.GetAccessTokenExpiresAt().AsTime(),
+			RefreshToken:          resp.GetRefreshToken(),
+			RefreshTokenExpiresAt: resp.GetRefreshTokenExpiresAt().AsTime(),
+		},
+	})
+}
+
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new pair. Reusing an exchanged token revokes the session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body RefreshRequest true "Refresh token"
+// @Success 200 {object} TokensResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /auth/refresh [post]
+func (h *AuthHandler) refresh(c *fiber.Ctx) error {
+	var req RefreshRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.Refresh(ctx, &userspb.RefreshRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(TokensResponse{
+		AccessToken:           resp.GetAccessToken(),
+		AccessTokenExpiresAt:  resp.GetAccessTokenExpiresAt().AsTime(),
+		RefreshToken:          resp.GetRefreshToken(),
+		RefreshTokenExpiresAt: resp.GetRefreshTokenExpiresAt().AsTime(),
+	})
+}
+
+// @Summary Log out
+// @Description End the session the refresh token belongs to
+// @Tags auth
+// @Accept json
+// @Param request body LogoutRequest true "Refresh token"
+// @Success 204
+// @Router /auth/logout [post]
+func (h *AuthHandler) logout(c *fiber.Ctx) error {
+	var req LogoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.Logout(ctx, &userspb.LogoutRequest{RefreshToken: req.RefreshToken}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Log out of all sessions
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} LogoutAllResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) logoutAll(c *fiber.Ctx) error {
+	// Пользователь уходит в Auth Service через metadata в rpcContext
+	if _, ok := middleware.UserID(c); !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.LogoutAll(ctx, &userspb.LogoutAllRequest{})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(LogoutAllResponse{RevokedSessions: int(resp.GetRevokedSessions())})
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/etag"
+	boardspb "Taskify/proto/boards/v1"
+)
+
+// BoardStateHandler — архивация доски и восстановление после удаления
+type BoardStateHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewBoardStateHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &BoardStateHandler{client: client, timeout: timeout}
+
+	boards := api.Group("/boards/:id")
+	boards.Post("/archive", handler.archiveBoard)
+	boards.Post("/unarchive", handler.unarchiveBoard)
+	boards.Post("/restore", handler.restoreBoard)
+}
+
+// @Summary Archive a board
+// @Description Hide a board from the default board list; it stays readable by ID
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/archive [post]
+func (h *BoardStateHandler) archiveBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ArchiveBoard(ctx, &boardspb.ArchiveBoardRequest{Id: int64(id)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Unarchive a board
+// @Description Return an archived board to the default board list
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/unarchive [post]
+func (h *BoardStateHandler) unarchiveBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.UnarchiveBoard(ctx, &boardspb.UnarchiveBoardRequest{Id: int64(id)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Restore a deleted board
+// @Description Undo board deletion before the board is purged
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/restore [post]
+func (h *BoardStateHandler) restoreBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.RestoreBoard(ctx, &boardspb.RestoreBoardRequest{Id: int64(id)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
@@ -0,0 +1,268 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Taskify/pkg/etag"
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type BoardHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewBoardHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &BoardHandler{client: client, timeout: timeout}
+
+	boards := api.Group("/boards")
+	boards.Post("/", handler.createBoard)
+	boards.Get("/:id", handler.getBoard)
+	boards.Get("/:id/structure", handler.getBoardStructure)
+	boards.Get("/", handler.listBoards)
+	boards.Patch("/:id", handler.updateBoard)
+	boards.Delete("/:id", handler.deleteBoard)
+	boards.Post("/:id/move", handler.moveBoard)
+}
+
+// @Summary Create a new board
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateBoardRequest true "Board creation info"
+// @Param Idempotency-Key header string false "Retried request with the same key returns the original board"
+// @Success 201 {object} BoardResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /boards [post]
+func (h *BoardHandler) createBoard(c *fiber.Ctx) error {
+	var req CreateBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.CreateBoard(ctx, &boardspb.CreateBoardRequest{
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.Status(fiber.StatusCreated).JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Get a board
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id} [get]
+func (h *BoardHandler) getBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.GetBoard(ctx, &boardspb.GetBoardRequest{Id: int64(id)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Get a board structure
+// @Description Get a board with all its columns and their tasks, ordered by position
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {object} BoardStructureResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/structure [get]
+func (h *BoardHandler) getBoardStructure(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.GetBoardStructure(ctx, &boardspb.GetBoardStructureRequest{Id: int64(id)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toBoardStructureResponse(resp))
+}
+
+// @Summary List boards
+// @Description Get a page of boards the caller owns or is a member of
+// @Tags boards
+// @Produce json
+// @Security BearerAuth
+// @Param pageSize query int false "Page size, 20 by default, at most 100"
+// @Param pageToken query string false "nextPageToken from the previous page"
+// @Param search query string false "Case-insensitive title substring"
+// @Param sortBy query string false "created_at (default) or updated_at"
+// @Param order query string false "desc (default) or asc"
+// @Param archived query string false "active (default), archived, all or deleted (boards you own that can still be restored)"
+// @Success 200 {object} BoardListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /boards [get]
+func (h *BoardHandler) listBoards(c *fiber.Ctx) error {
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ListBoards(ctx, &boardspb.ListBoardsRequest{
+		PageSize:  int32(c.QueryInt("pageSize")),
+		PageToken: c.Query("pageToken"),
+		Search:    c.Query("search"),
+		SortBy:    c.Query("sortBy"),
+		Order:     c.Query("order"),
+		Archived:  c.Query("archived"),
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(BoardListResponse{
+		Boards:        toBoardResponses(resp.GetBoard()),
+		NextPageToken: resp.GetNextPageToken(),
+	})
+}
+
+// @Summary Update a board
+// @Description Update a board with optional fields: title, description
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param If-Match header string false "ETag of the board version being edited"
+// @Param request body UpdateBoardRequest true "Board update info"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Router /boards/{id} [patch]
+func (h *BoardHandler) updateBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req UpdateBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	expectedVersion, err := etag.ExpectedVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.UpdateBoard(ctx, &boardspb.UpdateBoardRequest{
+		Id:              int64(id),
+		Title:           req.Title,
+		Description:     req.Description,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		// Для условного запроса несовпадение версии — это 412, а не общий 409
+		if expectedVersion != nil && status.Code(err) == codes.Aborted {
+			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Delete a board
+// @Tags boards
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id} [delete]
+func (h *BoardHandler) deleteBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.DeleteBoard(ctx, &boardspb.DeleteBoardRequest{Id: int64(id)}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Move a board
+// @Description Transfer a board to another owner
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param request body MoveBoardRequest true "New owner"
+// @Success 200 {object} BoardResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/move [post]
+func (h *BoardHandler) moveBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.MoveBoard(ctx, &boardspb.MoveBoardRequest{
+		Id:    int64(id),
+		Owner: req.Owner,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
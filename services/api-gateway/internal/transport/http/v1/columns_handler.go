@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type ColumnHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewColumnHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &ColumnHandler{client: client, timeout: timeout}
+
+	columns := api.Group("/boards/:id/columns")
+	columns.Post("/", handler.createColumn)
+	columns.Patch("/:columnId", handler.renameColumn)
+	columns.Post("/:columnId/move", handler.moveColumn)
+	columns.Delete("/:columnId", handler.deleteColumn)
+}
+
+// parseColumnParams читает id доски и колонки из пути
+func parseColumnParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	columnID, err := c.ParamsInt("columnId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(columnID), nil
+}
+
+// @Summary Create a column
+// @Description Append a new column to the end of the board
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param request body CreateColumnRequest true "Column creation info"
+// @Success 201 {object} ColumnResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/columns [post]
+func (h *ColumnHandler) createColumn(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.CreateColumn(ctx, &boardspb.CreateColumnRequest{
+		BoardId: int64(boardID),
+		Title:   req.Title,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toColumnResponse(resp.GetColumn()))
+}
+
+// @Summary Rename a column
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body RenameColumnRequest true "New title"
+// @Success 200 {object} ColumnResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/columns/{columnId} [patch]
+func (h *ColumnHandler) renameColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req RenameColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.RenameColumn(ctx, &boardspb.RenameColumnRequest{
+		BoardId: boardID,
+		Id:      columnID,
+		Title:   req.Title,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toColumnResponse(resp.GetColumn()))
+}
+
+// @Summary Move a column
+// @Description Move a column to another position on the board
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body MoveColumnRequest true "Target position"
+// @Success 200 {object} ColumnResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/columns/{columnId}/move [post]
+func (h *ColumnHandler) moveColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.MoveColumn(ctx, &boardspb.MoveColumnRequest{
+		BoardId:  boardID,
+		Id:       columnID,
+		Position: req.Position,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toColumnResponse(resp.GetColumn()))
+}
+
+// @Summary Delete a column
+// @Description Delete a column with all its tasks
+// @Tags columns
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/columns/{columnId} [delete]
+func (h *ColumnHandler) deleteColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.DeleteColumn(ctx, &boardspb.DeleteColumnRequest{BoardId: boardID, Id: columnID}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
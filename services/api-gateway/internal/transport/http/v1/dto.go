@@ -0,0 +1,217 @@
+package v1
+
+import "time"
+
+// --- Auth ---
+
+type RegisterRequest struct {
+	Email    string `json:"email" example:"user@example.com"`
+	Username string `json:"username" example:"user"`
+	Password string `json:"password" example:"secret-password"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" example:"user@example.com"`
+	Password string `json:"password" example:"secret-password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type UserResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	Email     string    `json:"email" example:"user@example.com"`
+	Username  string    `json:"username" example:"user"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TokensResponse struct {
+	AccessToken           string    `json:"accessToken"`
+	AccessTokenExpiresAt  time.Time `json:"accessTokenExpiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+type LoginResponse struct {
+	User UserResponse `json:"user"`
+	TokensResponse
+}
+
+type LogoutAllResponse struct {
+	RevokedSessions int `json:"revokedSessions" example:"2"`
+}
+
+// --- Boards ---
+
+type CreateBoardRequest struct {
+	Title       string `json:"title" example:"Important thing"`
+	Description string `json:"description" example:"This is my board's description"`
+	// Владельцем становится пользователь из JWT; поле owner в теле игнорируется
+}
+
+type UpdateBoardRequest struct {
+	Title       *string `json:"title" example:"Important thing"` // Если поля нет в JSON, будет nil
+	Description *string `json:"description" example:"This is my board's description"`
+}
+
+type MoveBoardRequest struct {
+	Owner int64 `json:"owner" example:"2"` // Новый владелец; передает доску пользователь из JWT
+}
+
+type BoardResponse struct {
+	ID          int64     `json:"id" example:"1"`
+	Title       string    `json:"title" example:"Important thing"`
+	Description string    `json:"description" example:"This is my board's description"`
+	Owner       int64     `json:"owner" example:"1"`
+	Version     int64     `json:"version" example:"1"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	// null, если доска не в архиве
+	ArchivedAt *time.Time `json:"archivedAt"`
+	IsTemplate bool       `json:"isTemplate" example:"false"`
+	// null, если доска не удалена
+	DeletedAt *time.Time `json:"deletedAt"`
+}
+
+type CloneBoardRequest struct {
+	// Название копии; если не задано — как у исходной доски
+	Title *string `json:"title" example:"Sprint 12"`
+}
+
+type SetBoardTemplateRequest struct {
+	IsTemplate bool `json:"isTemplate" example:"true"`
+}
+
+type BoardListResponse struct {
+	Boards []BoardResponse `json:"boards"`
+	// Пустой на последней странице
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type BoardStructureResponse struct {
+	BoardResponse
+	Columns []ColumnStructureResponse `json:"columns"`
+}
+
+// --- Columns ---
+
+type CreateColumnRequest struct {
+	Title string `json:"title" example:"To Do"`
+}
+
+type RenameColumnRequest struct {
+	Title string `json:"title" example:"In Progress"`
+}
+
+type MoveColumnRequest struct {
+	Position int32 `json:"position" example:"0"`
+}
+
+type ColumnResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	BoardID   int64     `json:"boardId" example:"1"`
+	Title     string    `json:"title" example:"To Do"`
+	Position  int32     `json:"position" example:"0"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type ColumnStructureResponse struct {
+	ColumnResponse
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+// --- Tasks ---
+
+type CreateTaskRequest struct {
+	Title       string `json:"title" example:"Write migration"`
+	Description string `json:"description" example:"Add tasks table"`
+	AssigneeID  int64  `json:"assigneeId" example:"1"`
+}
+
+type UpdateTaskRequest struct {
+	Title       *string `json:"title" example:"Write migration"`
+	Description *string `json:"description" example:"Add tasks table"`
+	AssigneeID  *int64  `json:"assigneeId" example:"1"` // 0 снимает исполнителя
+}
+
+type MoveTaskRequest struct {
+	ColumnID int64 `json:"columnId" example:"2"`
+	Position int32 `json:"position" example:"0"`
+}
+
+type TaskResponse struct {
+	ID          int64     `json:"id" example:"1"`
+	ColumnID    int64     `json:"columnId" example:"1"`
+	Title       string    `json:"title" example:"Write migration"`
+	Description string    `json:"description" example:"Add tasks table"`
+	AssigneeID  int64     `json:"assigneeId" example:"1"` // 0 — исполнитель не назначен
+	Position    int32     `json:"position" example:"0"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// --- Members ---
+
+type AddMemberRequest struct {
+	UserID int64  `json:"userId" example:"2"`
+	Role   string `json:"role" example:"editor"` // admin, editor или viewer
+}
+
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" example:"viewer"`
+}
+
+type MemberResponse struct {
+	BoardID   int64     `json:"boardId" example:"1"`
+	UserID    int64     `json:"userId" example:"2"`
+	Role      string    `json:"role" example:"editor"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// --- Invitations ---
+
+type CreateInvitationRequest struct {
+	Email string `json:"email" example:"teammate@example.com"`
+	Role  string `json:"role" example:"editor"` // admin, editor или viewer
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" example:"q1w2e3r4t5y6"`
+}
+
+type InvitationResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	BoardID   int64     `json:"boardId" example:"1"`
+	Email     string    `json:"email" example:"teammate@example.com"`
+	Role      string    `json:"role" example:"editor"`
+	InvitedBy int64     `json:"invitedBy" example:"1"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error" example:"board not found"`
+}
+
+type ImportIssueResponse struct {
+	Kind     string `json:"kind" example:"card"`
+	SourceID string `json:"sourceId" example:"5f1b2c3d4e5f6a7b8c9d0e1f"`
+	Name     string `json:"name" example:"Write release notes"`
+	Reason   string `json:"reason" example:"card is archived in Trello"`
+}
+
+type ImportReportResponse struct {
+	Board   BoardResponse `json:"board"`
+	Columns int32         `json:"columns" example:"4"`
+	Tasks   int32         `json:"tasks" example:"37"`
+	// Найденные пользователи приглашены, а не добавлены участниками
+	Invitations int32                 `json:"invitations" example:"3"`
+	Issues      []ImportIssueResponse `json:"issues"`
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Taskify/services/api-gateway/internal/transport/http/middleware"
+)
+
+// httpStatus — единое соответствие кодов gRPC и HTTP статусов для всех хендлеров шлюза
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return fiber.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return fiber.StatusBadRequest
+	case codes.Unauthenticated:
+		return fiber.StatusUnauthorized
+	case codes.PermissionDenied:
+		return fiber.StatusForbidden
+	case codes.NotFound:
+		return fiber.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return fiber.StatusConflict
+	case codes.ResourceExhausted:
+		return fiber.StatusTooManyRequests
+	case codes.Canceled:
+		return fiber.StatusRequestTimeout
+	case codes.Unimplemented:
+		return fiber.StatusNotImplemented
+	case codes.Unavailable:
+		return fiber.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return fiber.StatusGatewayTimeout
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+// grpcError отвечает клиенту ошибкой вызова сервиса. Текст внутренних ошибок
+// наружу не отдается: в нем могут быть детали БД.
+func grpcError(c *fiber.Ctx, err error) error {
+	st := status.Convert(err)
+	code := httpStatus(st.Code())
+
+	message := st.Message()
+	if code >= fiber.StatusInternalServerError {
+		message = "upstream service error"
+		if code == fiber.StatusServiceUnavailable || code == fiber.StatusGatewayTimeout {
+			message = "upstream service unavailable"
+		}
+	}
+
+	return c.Status(code).JSON(fiber.Map{"error": message})
+}
+
+// rpcContext — контекст вызова gRPC с дедлайном, отменяется вместе с запросом.
+// Если запрос аутентифицирован, id пользователя уходит в сервис через metadata,
+// туда же переносится ключ идемпотентности из заголовка Idempotency-Key.
+func rpcContext(c *fiber.Ctx, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx := c.UserContext()
+
+	if userID, ok := middleware.UserID(c); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, middleware.UserIDMetadataKey, strconv.FormatInt(userID, 10))
+	}
+
+	if key := c.Get("Idempotency-Key"); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, middleware.IdempotencyMetadataKey, key)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Taskify/services/api-gateway/internal/transport/http/middleware"
+)
+
+func TestGRPCError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		message string
+	}{
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "title is required"), status: fiber.StatusBadRequest, message: "title is required"},
+		{name: "failed precondition", err: status.Error(codes.FailedPrecondition, "board is archived"), status: fiber.StatusBadRequest, message: "board is archived"},
+		{name: "unauthenticated", err: status.Error(codes.Unauthenticated, "no user"), status: fiber.StatusUnauthorized, message: "no user"},
+		{name: "permission denied", err: status.Error(codes.PermissionDenied, "forbidden"), status: fiber.StatusForbidden, message: "forbidden"},
+		{name: "not found", err: status.Error(codes.NotFound, "board not found"), status: fiber.StatusNotFound, message: "board not found"},
+		{name: "aborted", err: status.Error(codes.Aborted, "version conflict"), status: fiber.StatusConflict, message: "version conflict"},
+		{name: "already exists", err: status.Error(codes.AlreadyExists, "email is already taken"), status: fiber.StatusConflict, message: "email is already taken"},
+		{name: "resource exhausted", err: status.Error(codes.ResourceExhausted, "too large"), status: fiber.StatusTooManyRequests, message: "too large"},
+		// Текст внутренних ошибок наружу не уходит
+		{name: "internal", err: status.Error(codes.Internal, "pq: relation boards does not exist"), status: fiber.StatusInternalServerError, message: "upstream service error"},
+		{name: "not a status error", err: errors.New("dial tcp: connection refused"), status: fiber.StatusInternalServerError, message: "upstream service error"},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "connection refused"), status: fiber.StatusServiceUnavailable, message: "upstream service unavailable"},
+		{name: "deadline exceeded", err: status.Error(codes.DeadlineExceeded, "deadline"), status: fiber.StatusGatewayTimeout, message: "upstream service unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error { return grpcError(c, tt.err) })
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+
+			var body struct {
+				Error string `json:"error"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Error != tt.message {
+				t.Errorf("error = %q, want %q", body.Error, tt.message)
+			}
+		})
+	}
+}
+
+func TestRPCContextMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      int64
+		idempotency string
+		want        metadata.MD
+	}{
+		{name: "anonymous", want: metadata.MD{}},
+		{name: "authenticated", userID: 42, want: metadata.Pairs(middleware.UserIDMetadataKey, "42")},
+		{name: "with idempotency key", userID: 42, idempotency: "key-1", want: metadata.Pairs(middleware.UserIDMetadataKey, "42", middleware.IdempotencyMetadataKey, "key-1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got metadata.MD
+
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				if tt.userID != 0 {
+					// Так id кладет middleware.JWTAuth
+					c.Locals("userID", tt.userID)
+				}
+
+				ctx, cancel := rpcContext(c, time.Second)
+				defer cancel()
+
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("rpc context has no deadline")
+				}
+				got, _ = metadata.FromOutgoingContext(ctx)
+				return nil
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.idempotency != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotency)
+			}
+			if _, err := app.Test(req); err != nil {
+				t.Fatalf("request: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("metadata = %v, want %v", got, tt.want)
+			}
+			for key, values := range tt.want {
+				if len(got[key]) != 1 || got[key][0] != values[0] {
+					t.Errorf("metadata %s = %v, want %v", key, got[key], values)
+				}
+			}
+		})
+	}
+}
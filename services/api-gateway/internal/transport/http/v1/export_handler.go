@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type ExportHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewExportHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &ExportHandler{client: client, timeout: timeout}
+
+	api.Get("/boards/:id/export", handler.exportBoard)
+}
+
+// @Summary Export a board
+// @Description Download a board snapshot: json is a lossless versioned document, csv is a flat table of tasks, markdown is a checklist
+// @Tags boards
+// @Produce json,text/csv,text/markdown
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param format query string false "json (default), csv or markdown"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/export [get]
+func (h *ExportHandler) exportBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	stream, err := h.client.ExportBoard(ctx, &boardspb.ExportBoardRequest{
+		Id:     int64(id),
+		Format: c.Query("format"),
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	// Собираем файл целиком: ошибка посреди потока должна стать статусом ответа,
+	// а не обрезанным файлом с кодом 200
+	var (
+		body        bytes.Buffer
+		contentType string
+		fileName    string
+	)
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return grpcError(c, err)
+		}
+
+		if chunk.GetContentType() != "" {
+			contentType = chunk.GetContentType()
+			fileName = chunk.GetFileName()
+		}
+
+		body.Write(chunk.GetData())
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fileName))
+
+	return c.Send(body.Bytes())
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type ImportHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewImportHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &ImportHandler{client: client, timeout: timeout}
+
+	api.Post("/boards/import", handler.importBoard)
+	api.Post("/boards/import/trello", handler.importTrello)
+}
+
+// @Summary Import a board document
+// @Description Recreate a board from a JSON document produced by the board export (format=json), e.g. exported in another environment.
+// @Description Columns and tasks get new ids, users are matched by username. Matched members are invited with their former roles; members and assignees without a matching user are listed in the report.
+// @Description Documents of a newer incompatible version are rejected.
+// @Tags import
+// @Accept json,mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "Board JSON document; the raw JSON can be sent as the request body instead"
+// @Success 201 {object} ImportReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /boards/import [post]
+func (h *ImportHandler) importBoard(c *fiber.Ctx) error {
+	document, err := uploadedFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid upload"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ImportBoard(ctx, &boardspb.ImportBoardRequest{Document: document})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toImportReportResponse(resp))
+}
+
+// @Summary Import a Trello board
+// @Description Create a board from a Trello board JSON export. Lists become columns, cards become tasks,
+// @Description Trello members are matched to Taskify users by username and invited to the board as editors. Items that cannot be imported are listed in the report.
+// @Tags import
+// @Accept json,mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "Trello JSON export; the raw JSON can be sent as the request body instead"
+// @Success 201 {object} ImportReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /boards/import/trello [post]
+func (h *ImportHandler) importTrello(c *fiber.Ctx) error {
+	export, err := uploadedFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid upload"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ImportTrelloBoard(ctx, &boardspb.ImportTrelloBoardRequest{Export: export})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toImportReportResponse(resp))
+}
+
+// uploadedFile читает файл из поля file формы multipart, а для других типов — тело запроса целиком
+func uploadedFile(c *fiber.Ctx) ([]byte, error) {
+	if !strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return c.Body(), nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
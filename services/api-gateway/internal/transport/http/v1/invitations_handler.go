@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type InvitationHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewInvitationHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &InvitationHandler{client: client, timeout: timeout}
+
+	invitations := api.Group("/boards/:id/invitations")
+	invitations.Post("/", handler.createInvitation)
+	invitations.Get("/", handler.listInvitations)
+	invitations.Delete("/:invitationId", handler.revokeInvitation)
+
+	api.Post("/invitations/accept", handler.acceptInvitation)
+}
+
+// @Summary Invite to a board
+// @Description Send an invitation with a single-use expiring token to an email
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param request body CreateInvitationRequest true "Email and role"
+// @Success 201 {object} InvitationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/invitations [post]
+func (h *InvitationHandler) createInvitation(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.CreateBoardInvitation(ctx, &boardspb.CreateBoardInvitationRequest{
+		BoardId: int64(boardID),
+		Email:   req.Email,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toInvitationResponse(resp.GetInvitation()))
+}
+
+// @Summary List pending invitations
+// @Tags invitations
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {array} InvitationResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/invitations [get]
+func (h *InvitationHandler) listInvitations(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ListBoardInvitations(ctx, &boardspb.ListBoardInvitationsRequest{BoardId: int64(boardID)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	invitations := make([]InvitationResponse, 0, len(resp.GetInvitations()))
+	for _, i := range resp.GetInvitations() {
+		invitations = append(invitations, toInvitationResponse(i))
+	}
+
+	return c.JSON(invitations)
+}
+
+// @Summary Revoke an invitation
+// @Tags invitations
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param invitationId path int true "Invitation ID"
+// @Success 204
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/invitations/{invitationId} [delete]
+func (h *InvitationHandler) revokeInvitation(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	invitationID, err := c.ParamsInt("invitationId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.RevokeBoardInvitation(ctx, &boardspb.RevokeBoardInvitationRequest{BoardId: int64(boardID), Id: int64(invitationID)}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Accept an invitation
+// @Description Join the board with the token from the invitation email. Deleted boards answer 404, archived boards 400
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AcceptInvitationRequest true "Invitation token"
+// @Success 200 {object} MemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /invitations/accept [post]
+func (h *InvitationHandler) acceptInvitation(c *fiber.Ctx) error {
+	var req AcceptInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.AcceptBoardInvitation(ctx, &boardspb.AcceptBoardInvitationRequest{Token: req.Token})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toMemberResponse(resp.GetMember()))
+}
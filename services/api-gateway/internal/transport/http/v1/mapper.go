@@ -0,0 +1,138 @@
+package v1
+
+import (
+	boardspb "Taskify/proto/boards/v1"
+	userspb "Taskify/proto/users/v1"
+)
+
+// Маппинг protobuf -> JSON. Ответы собираются явно, а не через protojson,
+// чтобы формат REST API не зависел от имен полей в .proto.
+
+func toUserResponse(u *userspb.User) UserResponse {
+	return UserResponse{
+		ID:        u.GetId(),
+		Email:     u.GetEmail(),
+		Username:  u.GetUsername(),
+		CreatedAt: u.GetCreatedAt().AsTime(),
+	}
+}
+
+func toBoardResponse(b *boardspb.Board) BoardResponse {
+	resp := BoardResponse{
+		ID:          b.GetId(),
+		Title:       b.GetTitle(),
+		Description: b.GetDescription(),
+		Owner:       b.GetOwner(),
+		Version:     b.GetVersion(),
+		CreatedAt:   b.GetCreatedAt().AsTime(),
+		UpdatedAt:   b.GetUpdatedAt().AsTime(),
+		IsTemplate:  b.GetIsTemplate(),
+	}
+
+	if b.GetArchivedAt() != nil {
+		archivedAt := b.GetArchivedAt().AsTime()
+		resp.ArchivedAt = &archivedAt
+	}
+
+	if b.GetDeletedAt() != nil {
+		deletedAt := b.GetDeletedAt().AsTime()
+		resp.DeletedAt = &deletedAt
+	}
+
+	return resp
+}
+
+func toBoardResponses(boards []*boardspb.Board) []BoardResponse {
+	// Пустой список отдается как [], а не null
+	result := make([]BoardResponse, 0, len(boards))
+	for _, b := range boards {
+		result = append(result, toBoardResponse(b))
+	}
+	return result
+}
+
+func toColumnResponse(c *boardspb.Column) ColumnResponse {
+	return ColumnResponse{
+		ID:        c.GetId(),
+		BoardID:   c.GetBoardId(),
+		Title:     c.GetTitle(),
+		Position:  c.GetPosition(),
+		CreatedAt: c.GetCreatedAt().AsTime(),
+		UpdatedAt: c.GetUpdatedAt().AsTime(),
+	}
+}
+
+func toTaskResponse(t *boardspb.Task) TaskResponse {
+	return TaskResponse{
+		ID:          t.GetId(),
+		ColumnID:    t.GetColumnId(),
+		Title:       t.GetTitle(),
+		Description: t.GetDescription(),
+		AssigneeID:  t.GetAssigneeId(),
+		Position:    t.GetPosition(),
+		CreatedAt:   t.GetCreatedAt().AsTime(),
+		UpdatedAt:   t.GetUpdatedAt().AsTime(),
+	}
+}
+
+func toBoardStructureResponse(resp *boardspb.GetBoardStructureResponse) BoardStructureResponse {
+	columns := make([]ColumnStructureResponse, 0, len(resp.GetColumns()))
+	for _, cs := range resp.GetColumns() {
+		tasks := make([]TaskResponse, 0, len(cs.GetTasks()))
+		for _, t := range cs.GetTasks() {
+			tasks = append(tasks, toTaskResponse(t))
+		}
+
+		columns = append(columns, ColumnStructureResponse{
+			ColumnResponse: toColumnResponse(cs.GetColumn()),
+			Tasks:          tasks,
+		})
+	}
+
+	return BoardStructureResponse{
+		BoardResponse: toBoardResponse(resp.GetBoard()),
+		Columns:       columns,
+	}
+}
+
+func toMemberResponse(m *boardspb.BoardMember) MemberResponse {
+	return MemberResponse{
+		BoardID:   m.GetBoardId(),
+		UserID:    m.GetUserId(),
+		Role:      m.GetRole(),
+		CreatedAt: m.GetCreatedAt().AsTime(),
+		UpdatedAt: m.GetUpdatedAt().AsTime(),
+	}
+}
+
+func toInvitationResponse(i *boardspb.BoardInvitation) InvitationResponse {
+	return InvitationResponse{
+		ID:        i.GetId(),
+		BoardID:   i.GetBoardId(),
+		Email:     i.GetEmail(),
+		Role:      i.GetRole(),
+		InvitedBy: i.GetInvitedBy(),
+		ExpiresAt: i.GetExpiresAt().AsTime(),
+		CreatedAt: i.GetCreatedAt().AsTime(),
+	}
+}
+
+func toImportReportResponse(r *boardspb.ImportBoardResponse) ImportReportResponse {
+	issues := make([]ImportIssueResponse, 0, len(r.GetIssues()))
+	for _, i := range r.GetIssues() {
+		issues = append(issues, ImportIssueResponse{
+			Kind:     i.GetKind(),
+			SourceID: i.GetSourceId(),
+			Name:     i.GetName(),
+			Reason:   i.GetReason(),
+		})
+	}
+
+	return ImportReportResponse{
+		Board:       toBoardResponse(r.GetBoard()),
+		Columns:     r.GetColumns(),
+		Tasks:       r.GetTasks(),
+		Invitations: r.GetInvitations(),
+		Issues:      issues,
+	}
+}
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type MemberHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewMemberHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &MemberHandler{client: client, timeout: timeout}
+
+	members := api.Group("/boards/:id/members")
+	members.Get("/", handler.listMembers)
+	members.Post("/", handler.addMember)
+	members.Patch("/:userId", handler.updateMemberRole)
+	members.Delete("/:userId", handler.removeMember)
+}
+
+// parseMemberParams читает id доски и пользователя из пути
+func parseMemberParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := c.ParamsInt("userId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(userID), nil
+}
+
+// @Summary List board members
+// @Tags members
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 200 {array} MemberResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/members [get]
+func (h *MemberHandler) listMembers(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ListBoardMembers(ctx, &boardspb.ListBoardMembersRequest{BoardId: int64(boardID)})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	members := make([]MemberResponse, 0, len(resp.GetMembers()))
+	for _, m := range resp.GetMembers() {
+		members = append(members, toMemberResponse(m))
+	}
+
+	return c.JSON(members)
+}
+
+// @Summary Add a board member
+// @Description Add a user to the board with role admin, editor or viewer
+// @Tags members
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param request body AddMemberRequest true "User and role"
+// @Success 201 {object} MemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /boards/{id}/members [post]
+func (h *MemberHandler) addMember(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req AddMemberRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.AddBoardMember(ctx, &boardspb.AddBoardMemberRequest{
+		BoardId: int64(boardID),
+		UserId:  req.UserID,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toMemberResponse(resp.GetMember()))
+}
+
+// @Summary Change a member role
+// @Tags members
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param userId path int true "User ID"
+// @Param request body UpdateMemberRoleRequest true "New role"
+// @Success 200 {object} MemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/members/{userId} [patch]
+func (h *MemberHandler) updateMemberRole(c *fiber.Ctx) error {
+	boardID, userID, err := parseMemberParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req UpdateMemberRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.UpdateBoardMemberRole(ctx, &boardspb.UpdateBoardMemberRoleRequest{
+		BoardId: boardID,
+		UserId:  userID,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toMemberResponse(resp.GetMember()))
+}
+
+// @Summary Remove a board member
+// @Description Remove a member; any member can remove themselves
+// @Tags members
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param userId path int true "User ID"
+// @Success 204
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/members/{userId} [delete]
+func (h *MemberHandler) removeMember(c *fiber.Ctx) error {
+	boardID, userID, err := parseMemberParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.RemoveBoardMember(ctx, &boardspb.RemoveBoardMemberRequest{BoardId: boardID, UserId: userID}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
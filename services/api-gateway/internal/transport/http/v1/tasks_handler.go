@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type TaskHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewTaskHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &TaskHandler{client: client, timeout: timeout}
+
+	boardRoutes := api.Group("/boards/:id")
+	boardRoutes.Post("/columns/:columnId/tasks", handler.createTask)
+	boardRoutes.Get("/tasks/:taskId", handler.getTask)
+	boardRoutes.Patch("/tasks/:taskId", handler.updateTask)
+	boardRoutes.Delete("/tasks/:taskId", handler.deleteTask)
+	boardRoutes.Post("/tasks/:taskId/move", handler.moveTask)
+}
+
+// parseTaskParams читает id доски и задачи из пути
+func parseTaskParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	taskID, err := c.ParamsInt("taskId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(taskID), nil
+}
+
+// @Summary Create a task
+// @Description Append a new task to the end of the column
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body CreateTaskRequest true "Task creation info"
+// @Success 201 {object} TaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/columns/{columnId}/tasks [post]
+func (h *TaskHandler) createTask(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.CreateTask(ctx, &boardspb.CreateTaskRequest{
+		BoardId:     boardID,
+		ColumnId:    columnID,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeId:  req.AssigneeID,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTaskResponse(resp.GetTask()))
+}
+
+// @Summary Get a task
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Success 200 {object} TaskResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/tasks/{taskId} [get]
+func (h *TaskHandler) getTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.GetTask(ctx, &boardspb.GetTaskRequest{BoardId: boardID, Id: taskID})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toTaskResponse(resp.GetTask()))
+}
+
+// @Summary Update a task
+// @Description Update a task with optional fields: title, description, assigneeId
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Param request body UpdateTaskRequest true "Task update info"
+// @Success 200 {object} TaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/tasks/{taskId} [patch]
+func (h *TaskHandler) updateTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req UpdateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.UpdateTask(ctx, &boardspb.UpdateTaskRequest{
+		BoardId:     boardID,
+		Id:          taskID,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeId:  req.AssigneeID,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toTaskResponse(resp.GetTask()))
+}
+
+// @Summary Delete a task
+// @Tags tasks
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/tasks/{taskId} [delete]
+func (h *TaskHandler) deleteTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	if _, err := h.client.DeleteTask(ctx, &boardspb.DeleteTaskRequest{BoardId: boardID, Id: taskID}); err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Move a task
+// @Description Move a task to another column and/or position
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Param request body MoveTaskRequest true "Target column and position"
+// @Success 200 {object} TaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/tasks/{taskId}/move [post]
+func (h *TaskHandler) moveTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.MoveTask(ctx, &boardspb.MoveTaskRequest{
+		BoardId:  boardID,
+		Id:       taskID,
+		ColumnId: req.ColumnID,
+		Position: req.Position,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toTaskResponse(resp.GetTask()))
+}
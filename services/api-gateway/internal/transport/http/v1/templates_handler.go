@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/etag"
+	boardspb "Taskify/proto/boards/v1"
+)
+
+type TemplateHandler struct {
+	client  boardspb.BoardServiceClient
+	timeout time.Duration
+}
+
+func NewTemplateHandler(api fiber.Router, client boardspb.BoardServiceClient, timeout time.Duration) {
+	handler := &TemplateHandler{client: client, timeout: timeout}
+
+	api.Post("/boards/:id/clone", handler.cloneBoard)
+	api.Put("/boards/:id/template", handler.setBoardTemplate)
+	api.Get("/templates", handler.listTemplates)
+}
+
+// @Summary Clone a board
+// @Description Copy a board or template with its columns and tasks into a new board owned by the caller
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Source board ID"
+// @Param request body CloneBoardRequest false "Optional title of the copy"
+// @Success 201 {object} BoardResponse
+// @Header 201 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/clone [post]
+func (h *TemplateHandler) cloneBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	// Тело необязательно: без него копия получает название исходной доски
+	var req CloneBoardRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.CloneBoard(ctx, &boardspb.CloneBoardRequest{
+		Id:    int64(id),
+		Title: req.Title,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.Status(fiber.StatusCreated).JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary Mark a board as template
+// @Description Mark a board as template or clear the mark
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Param request body SetBoardTemplateRequest true "Template flag"
+// @Success 200 {object} BoardResponse
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/template [put]
+func (h *TemplateHandler) setBoardTemplate(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req SetBoardTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.SetBoardTemplate(ctx, &boardspb.SetBoardTemplateRequest{
+		Id:         int64(id),
+		IsTemplate: req.IsTemplate,
+	})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	etag.SetVersion(c, resp.GetBoard().GetVersion())
+
+	return c.JSON(toBoardResponse(resp.GetBoard()))
+}
+
+// @Summary List templates
+// @Description Get template boards the caller owns or is a member of
+// @Tags templates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} BoardResponse
+// @Router /templates [get]
+func (h *TemplateHandler) listTemplates(c *fiber.Ctx) error {
+	ctx, cancel := rpcContext(c, h.timeout)
+	defer cancel()
+
+	resp, err := h.client.ListBoardTemplates(ctx, &boardspb.ListBoardTemplatesRequest{})
+	if err != nil {
+		return grpcError(c, err)
+	}
+
+	return c.JSON(toBoardResponses(resp.GetBoards()))
+}
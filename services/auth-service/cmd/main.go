@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	pb "Taskify/proto/users/v1"
+
+	"Taskify/services/auth-service/internal/config"
+	"Taskify/services/auth-service/internal/infrastructure/persistence"
+	"Taskify/services/auth-service/internal/infrastructure/security"
+	"Taskify/services/auth-service/internal/infrastructure/token"
+	grpcHandler "Taskify/services/auth-service/internal/transport/grpc"
+	usecaseAuth "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serviceConfig := config.MustLoad()
+
+	setupLogger(serviceConfig.Env)
+
+	// 1. Подключение к БД
+	dbPool, err := pgxpool.New(ctx, serviceConfig.Postgres.URL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Unable to connect to DB")
+	}
+	defer dbPool.Close()
+
+	if err := dbPool.Ping(ctx); err != nil {
+		log.Fatal().Err(err).Msg("DB Ping failed")
+	}
+	log.Printf("Successfully connected to Database")
+
+	// 2. Инициализация слоев
+	userRepo := persistence.NewUserRepository(dbPool)
+	refreshRepo := persistence.NewRefreshTokenRepository(dbPool)
+	txManager := persistence.NewTxManager(dbPool)
+	hasher := security.NewBcryptHasher()
+	jwtManager := token.NewJWTManager(serviceConfig.JWT.Secret, serviceConfig.JWT.Issuer, serviceConfig.JWT.AccessTTL)
+	refreshGenerator := token.NewRefreshTokenGenerator()
+	sessions := usecaseAuth.NewSessionIssuer(refreshRepo, refreshGenerator, jwtManager, serviceConfig.JWT.RefreshTTL)
+
+	registerUC := usecaseAuth.NewRegisterUseCase(userRepo, hasher)
+	loginUC, err := usecaseAuth.NewLoginUseCase(userRepo, hasher, sessions)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to init login use case")
+	}
+	refreshUC := usecaseAuth.NewRefreshUseCase(txManager, userRepo, refreshRepo, refreshGenerator, sessions)
+	logoutUC := usecaseAuth.NewLogoutUseCase(txManager, refreshRepo, refreshGenerator)
+	logoutAllUC := usecaseAuth.NewLogoutAllUseCase(refreshRepo)
+
+	// 3. gRPC сервер
+	lis, err := net.Listen("tcp", serviceConfig.GRPC.Port)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to listen")
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcHandler.IdentityInterceptor()))
+	pb.RegisterUserServiceServer(grpcServer, grpcHandler.NewHandler(registerUC, loginUC, refreshUC, logoutUC, logoutAllUC))
+	reflection.Register(grpcServer)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("Auth Service (gRPC) is running on port %s", serviceConfig.GRPC.Port)
+
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatal().Err(err).Msg("Failed to serve gRPC")
+	}
+}
+
+func setupLogger(env string) {
+	switch env {
+	case "local":
+		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "15:04:05"})
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "dev":
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "prod":
+		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	}
+}
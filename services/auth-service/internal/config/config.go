@@ -0,0 +1,53 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type Config struct {
+	Env      string `yaml:"env" env:"ENV" env-default:"local"` // local, dev, prod
+	Postgres PostgresConfig
+	JWT      JWTConfig
+	GRPC     GRPCConfig
+}
+
+type PostgresConfig struct {
+	URL string `env:"DATABASE_URL" env-required:"true"`
+}
+
+type JWTConfig struct {
+	// Общий с API Gateway секрет для подписи HS256
+	Secret    string        `env:"JWT_SECRET" env-required:"true"`
+	Issuer    string        `env:"JWT_ISSUER" env-default:"taskify-auth"`
+	AccessTTL time.Duration `env:"JWT_ACCESS_TTL" env-default:"15m"`
+	// Срок жизни refresh токена; каждая ротация выдает новый токен с полным сроком
+	RefreshTTL time.Duration `env:"JWT_REFRESH_TTL" env-default:"720h"`
+}
+
+type GRPCConfig struct {
+	Port string `env:"GRPC_PORT" env-default:":50053"`
+}
+
+func MustLoad() *Config {
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "./config/auth.env" // Дефолт для локальной разработки
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		log.Printf("Config file %s not found, reading from ENV variables", configPath)
+	}
+
+	var cfg Config
+	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
+		if err := cleanenv.ReadEnv(&cfg); err != nil {
+			log.Fatalf("cannot read config: %s", err)
+		}
+	}
+
+	return &cfg
+}
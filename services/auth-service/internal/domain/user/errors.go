@@ -0,0 +1,18 @@
+package user
+
+import "errors"
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrEmptyUserID        = errors.New("user id is empty")
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrInvalidUsername    = errors.New("username must be 3-32 characters: letters, digits, '.', '_' or '-'")
+	ErrPasswordTooShort   = errors.New("password is too short")
+	ErrPasswordTooLong    = errors.New("password is too long")
+	ErrEmailTaken         = errors.New("email is already taken")
+	ErrUsernameTaken      = errors.New("username is already taken")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected, session revoked")
+)
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"time"
+)
+
+// RefreshToken — серверная запись о выданном refresh токене.
+// Каждый токен одноразовый: при обмене он помечается использованным (UsedAt)
+// и вместо него выдается следующий той же семьи (FamilyID).
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// NewRefreshToken создает токен семьи familyID; пустой familyID — начало новой сессии
+func NewRefreshToken(userID int64, familyID, tokenHash string, ttl time.Duration) *RefreshToken {
+	now := time.Now()
+
+	return &RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
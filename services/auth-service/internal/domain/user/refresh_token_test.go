@@ -0,0 +1,53 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRefreshTokenState(t *testing.T) {
+	now := time.Now()
+	used, revoked := now.Add(-time.Minute), now.Add(-time.Second)
+
+	tests := []struct {
+		name    string
+		token   RefreshToken
+		used    bool
+		revoked bool
+		expired bool
+	}{
+		{name: "fresh", token: RefreshToken{ExpiresAt: now.Add(time.Hour)}},
+		{name: "used", token: RefreshToken{ExpiresAt: now.Add(time.Hour), UsedAt: &used}, used: true},
+		{name: "revoked", token: RefreshToken{ExpiresAt: now.Add(time.Hour), RevokedAt: &revoked}, revoked: true},
+		{name: "expires right now", token: RefreshToken{ExpiresAt: now}, expired: true},
+		{name: "expired", token: RefreshToken{ExpiresAt: now.Add(-time.Hour)}, expired: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.IsUsed(); got != tt.used {
+				t.Errorf("IsUsed = %v, want %v", got, tt.used)
+			}
+			if got := tt.token.IsRevoked(); got != tt.revoked {
+				t.Errorf("IsRevoked = %v, want %v", got, tt.revoked)
+			}
+			if got := tt.token.IsExpired(now); got != tt.expired {
+				t.Errorf("IsExpired = %v, want %v", got, tt.expired)
+			}
+		})
+	}
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	token := NewRefreshToken(7, "family", "hash", time.Hour)
+
+	if token.UserID != 7 || token.FamilyID != "family" || token.TokenHash != "hash" {
+		t.Errorf("token = %+v", token)
+	}
+	if got := token.ExpiresAt.Sub(token.CreatedAt); got != time.Hour {
+		t.Errorf("lifetime = %v, want 1h", got)
+	}
+	if token.IsUsed() || token.IsRevoked() || token.IsExpired(token.CreatedAt) {
+		t.Errorf("new token is not active: %+v", token)
+	}
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+)
+
+type Repository interface {
+	// Create возвращает ErrEmailTaken или ErrUsernameTaken, если значение уже занято
+	Create(ctx context.Context, user *User) error
+
+	GetByID(ctx context.Context, id int64) (*User, error)
+
+	GetByEmail(ctx context.Context, email string) (*User, error)
+}
+
+type RefreshTokenRepository interface {
+	// Create сохраняет токен; если FamilyID пуст, БД заводит новую семью и заполняет его
+	Create(ctx context.Context, token *RefreshToken) error
+
+	// GetByHashForUpdate находит токен по хешу и блокирует его до конца транзакции
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	MarkUsed(ctx context.Context, id int64) error
+
+	// RevokeFamily отзывает все еще не отозванные токены семьи
+	RevokeFamily(ctx context.Context, familyID string) error
+
+	// RevokeAllByUser отзывает все сессии пользователя и возвращает число отозванных семей
+	RevokeAllByUser(ctx context.Context, userID int64) (int, error)
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	MinPasswordLength = 8
+	// bcrypt учитывает только первые 72 байта, длиннее пароль молча обрезался бы
+	MaxPasswordBytes = 72
+)
+
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{3,32}$`)
+
+// User — учетная запись. Пароль хранится только в виде хеша.
+type User struct {
+	ID           int64
+	Email        string
+	Username     string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewUser создает пользователя с уже посчитанным хешем пароля.
+// Сырой пароль проверяется отдельно, через ValidatePassword.
+func NewUser(email, username, passwordHash string) (*User, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usernamePattern.MatchString(username) {
+		return nil, ErrInvalidUsername
+	}
+
+	return &User{
+		Email:        email,
+		Username:     username,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}, nil
+}
+
+// NormalizeEmail проверяет адрес и приводит его к нижнему регистру,
+// чтобы Foo@x.io и foo@x.io считались одним пользователем
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+
+	return email, nil
+}
+
+func ValidatePassword(password string) error {
+	if len([]rune(password)) < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	if len(password) > MaxPasswordBytes {
+		return ErrPasswordTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		username  string
+		wantEmail string
+		err       error
+	}{
+		{name: "valid", email: "bob@example.com", username: "bob", wantEmail: "bob@example.com"},
+		{name: "email is normalized", email: "  Bob@Example.COM ", username: "bob", wantEmail: "bob@example.com"},
+		{name: "username with punctuation", email: "bob@example.com", username: "bob.smith_2-x", wantEmail: "bob@example.com"},
+		{name: "username at the limit", email: "bob@example.com", username: strings.Repeat("b", 32), wantEmail: "bob@example.com"},
+		{name: "email without domain", email: "bob", username: "bob", err: ErrInvalidEmail},
+		{name: "email with display name", email: "Bob <bob@example.com>", username: "bob", err: ErrInvalidEmail},
+		{name: "empty email", email: "", username: "bob", err: ErrInvalidEmail},
+		{name: "username too short", email: "bob@example.com", username: "bo", err: ErrInvalidUsername},
+		{name: "username too long", email: "bob@example.com", username: strings.Repeat("b", 33), err: ErrInvalidUsername},
+		{name: "username with space", email: "bob@example.com", username: "bob smith", err: ErrInvalidUsername},
+		{name: "username with cyrillic", email: "bob@example.com", username: "боб", err: ErrInvalidUsername},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := NewUser(tt.email, tt.username, "hash")
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if u.Email != tt.wantEmail || u.Username != tt.username || u.PasswordHash != "hash" {
+				t.Errorf("user = %+v", u)
+			}
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		err      error
+	}{
+		{name: "minimum length", password: strings.Repeat("a", MinPasswordLength)},
+		{name: "too short", password: strings.Repeat("a", MinPasswordLength-1), err: ErrPasswordTooShort},
+		// Длина считается в символах: 8 кириллических букв — это 16 байт, но пароль допустим
+		{name: "short in runes, long in bytes", password: strings.Repeat("я", MinPasswordLength)},
+		{name: "maximum bytes", password: strings.Repeat("a", MaxPasswordBytes)},
+		{name: "too many bytes", password: strings.Repeat("a", MaxPasswordBytes+1), err: ErrPasswordTooLong},
+		{name: "too many bytes in runes", password: strings.Repeat("я", MaxPasswordBytes/2+1), err: ErrPasswordTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePassword(tt.password); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
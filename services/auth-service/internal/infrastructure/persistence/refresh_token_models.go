@@ -0,0 +1,39 @@
+package persistence
+
+import (
+	"database/sql"
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type RefreshTokenModel struct {
+	ID        int64        `db:"id"`
+	UserID    int64        `db:"user_id"`
+	FamilyID  string       `db:"family_id"`
+	TokenHash string       `db:"token_hash"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+func (m *RefreshTokenModel) toDomain() *user.RefreshToken {
+	token := &user.RefreshToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		FamilyID:  m.FamilyID,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+	}
+
+	if m.UsedAt.Valid {
+		token.UsedAt = &m.UsedAt.Time
+	}
+	if m.RevokedAt.Valid {
+		token.RevokedAt = &m.RevokedAt.Time
+	}
+
+	return token
+}
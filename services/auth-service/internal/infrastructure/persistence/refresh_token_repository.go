@@ -0,0 +1,99 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Taskify/services/auth-service/internal/domain/user"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ user.RefreshTokenRepository = (*RefreshTokenRepository)(nil)
+
+type RefreshTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, t *user.RefreshToken) error {
+	query := `INSERT INTO refresh_tokens(user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, COALESCE(NULLIF($2, '')::uuid, gen_random_uuid()), $3, $4, $5)
+		RETURNING id, family_id::text`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, t.UserID, t.FamilyID, t.TokenHash, t.ExpiresAt, t.CreatedAt).Scan(&t.ID, &t.FamilyID)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*user.RefreshToken, error) {
+	query := `SELECT id, user_id, family_id::text, token_hash, expires_at, created_at, used_at, revoked_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+		FOR UPDATE`
+
+	var model RefreshTokenModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, tokenHash).Scan(
+		&model.ID,
+		&model.UserID,
+		&model.FamilyID,
+		&model.TokenHash,
+		&model.ExpiresAt,
+		&model.CreatedAt,
+		&model.UsedAt,
+		&model.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, id int64) error {
+	query := "UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark refresh token as used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	query := "UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, familyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllByUser(ctx context.Context, userID int64) (int, error) {
+	query := `WITH revoked AS (
+			UPDATE refresh_tokens SET revoked_at = NOW()
+			WHERE user_id = $1 AND revoked_at IS NULL
+			RETURNING family_id
+		)
+		SELECT COUNT(DISTINCT family_id) FROM revoked`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type UserModel struct {
+	ID           int64     `db:"id"`
+	Email        string    `db:"email"`
+	Username     string    `db:"username"`
+	PasswordHash string    `db:"password_hash"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+func (m *UserModel) toDomain() *user.User {
+	return &user.User{
+		ID:           m.ID,
+		Email:        m.Email,
+		Username:     m.Username,
+		PasswordHash: m.PasswordHash,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
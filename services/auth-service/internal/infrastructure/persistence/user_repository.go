@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Taskify/services/auth-service/internal/domain/user"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ user.Repository = (*UserRepository)(nil)
+
+type UserRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserRepository(db *pgxpool.Pool) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
+	query := "INSERT INTO users(email, username, password_hash, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, u.Email, u.Username, u.PasswordHash, u.CreatedAt, u.UpdatedAt).Scan(&u.ID)
+	if err != nil {
+		return mapUserError(err)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, id int64) (*user.User, error) {
+	query := "SELECT id, email, username, password_hash, created_at, updated_at FROM users WHERE id = $1"
+
+	var model UserModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(&model.ID, &model.Email, &model.Username, &model.PasswordHash, &model.CreatedAt, &model.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	query := "SELECT id, email, username, password_hash, created_at, updated_at FROM users WHERE email = $1"
+
+	var model UserModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, email).Scan(&model.ID, &model.Email, &model.Username, &model.PasswordHash, &model.CreatedAt, &model.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// mapUserError переводит нарушения уникальности в доменные ошибки по имени ограничения
+func mapUserError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		switch pgErr.ConstraintName {
+		case "users_email_key":
+			return user.ErrEmailTaken
+		case "users_username_key":
+			return user.ErrUsernameTaken
+		}
+	}
+
+	return fmt.Errorf("failed to create user: %w", err)
+}
@@ -0,0 +1,43 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"Taskify/services/auth-service/internal/domain/user"
+	usecase "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+// BcryptCost — стоимость хеширования, под неё заведен и тестовый пользователь из миграции 001
+const BcryptCost = 12
+
+var _ usecase.PasswordHasher = (*BcryptHasher)(nil)
+
+type BcryptHasher struct{}
+
+func NewBcryptHasher() *BcryptHasher {
+	return &BcryptHasher{}
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), BcryptCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return string(hash), nil
+}
+
+func (h *BcryptHasher) Compare(hash, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return user.ErrInvalidCredentials
+		}
+		return fmt.Errorf("failed to compare password: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package security
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+func TestBcryptHasher(t *testing.T) {
+	h := NewBcryptHasher()
+
+	hash, err := h.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	if cost, err := bcrypt.Cost([]byte(hash)); err != nil || cost != BcryptCost {
+		t.Fatalf("cost = %d (err %v), want %d", cost, err, BcryptCost)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		err      error
+		// other — ожидается ошибка, отличная от ErrInvalidCredentials
+		other bool
+	}{
+		{name: "correct password", hash: hash, password: "correct horse"},
+		{name: "wrong password", hash: hash, password: "battery staple", err: user.ErrInvalidCredentials},
+		{name: "empty password", hash: hash, password: "", err: user.ErrInvalidCredentials},
+		{name: "password of another case", hash: hash, password: strings.ToUpper("correct horse"), err: user.ErrInvalidCredentials},
+		// Испорченный хеш — сбой сервера, а не неверный пароль
+		{name: "broken hash", hash: "not-a-bcrypt-hash", password: "correct horse", other: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := h.Compare(tt.hash, tt.password)
+			if tt.other {
+				if err == nil || errors.Is(err, user.ErrInvalidCredentials) {
+					t.Fatalf("err = %v, want an internal error", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
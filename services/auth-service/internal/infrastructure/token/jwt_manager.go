@@ -0,0 +1,55 @@
+package token
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"Taskify/services/auth-service/internal/domain/user"
+	usecase "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+var _ usecase.TokenIssuer = (*JWTManager)(nil)
+
+// Claims — полезная нагрузка access токена. Subject — id пользователя.
+type Claims struct {
+	Username string `json:"username"`
+	jwt.RegisteredClaims
+}
+
+// JWTManager подписывает access токены HS256 общим секретом,
+// по этому же секрету их проверяет API Gateway
+type JWTManager struct {
+	secret    []byte
+	issuer    string
+	accessTTL time.Duration
+}
+
+func NewJWTManager(secret, issuer string, accessTTL time.Duration) *JWTManager {
+	return &JWTManager{secret: []byte(secret), issuer: issuer, accessTTL: accessTTL}
+}
+
+func (m *JWTManager) IssueAccessToken(u *user.User) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.accessTTL)
+
+	claims := Claims{
+		Username: u.Username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(u.ID, 10),
+			Issuer:    m.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return signed, expiresAt, nil
+}
@@ -0,0 +1,71 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+func TestJWTManagerIssueAccessToken(t *testing.T) {
+	const secret = "test-secret"
+
+	m := NewJWTManager(secret, "taskify-auth", 15*time.Minute)
+	signed, expiresAt, err := m.IssueAccessToken(&user.User{ID: 42, Username: "bob"})
+	if err != nil {
+		t.Fatalf("IssueAccessToken: %v", err)
+	}
+	if d := time.Until(expiresAt); d <= 14*time.Minute || d > 15*time.Minute {
+		t.Errorf("expires in %v, want 15m", d)
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		method string
+		valid  bool
+	}{
+		{name: "same secret", secret: secret, method: "HS256", valid: true},
+		{name: "another secret", secret: "other", method: "HS256", valid: false},
+		{name: "another algorithm expected", secret: secret, method: "HS512", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var claims Claims
+			_, err := jwt.ParseWithClaims(signed, &claims, func(*jwt.Token) (any, error) {
+				return []byte(tt.secret), nil
+			}, jwt.WithValidMethods([]string{tt.method}), jwt.WithIssuer("taskify-auth"))
+			if (err == nil) != tt.valid {
+				t.Fatalf("err = %v, want valid %v", err, tt.valid)
+			}
+			if !tt.valid {
+				return
+			}
+
+			if claims.Subject != "42" || claims.Username != "bob" {
+				t.Errorf("claims = %+v, want subject 42 and username bob", claims)
+			}
+			if !claims.ExpiresAt.Time.Equal(expiresAt.Truncate(time.Second)) {
+				t.Errorf("exp = %v, want %v", claims.ExpiresAt.Time, expiresAt)
+			}
+		})
+	}
+}
+
+func TestJWTManagerExpiredToken(t *testing.T) {
+	m := NewJWTManager("test-secret", "taskify-auth", -time.Minute)
+	signed, _, err := m.IssueAccessToken(&user.User{ID: 1, Username: "bob"})
+	if err != nil {
+		t.Fatalf("IssueAccessToken: %v", err)
+	}
+
+	_, err = jwt.ParseWithClaims(signed, &Claims{}, func(*jwt.Token) (any, error) {
+		return []byte("test-secret"), nil
+	})
+	if err == nil {
+		t.Fatal("expired token is accepted")
+	}
+}
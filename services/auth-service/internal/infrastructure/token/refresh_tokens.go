@@ -0,0 +1,41 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	usecase "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+// refreshTokenBytes — энтропия refresh токена, 256 бит
+const refreshTokenBytes = 32
+
+var _ usecase.RefreshTokenGenerator = (*RefreshTokenGenerator)(nil)
+
+// RefreshTokenGenerator выдает непрозрачные случайные refresh токены.
+// В БД попадает только sha256: утечка таблицы не дает готовых токенов,
+// а соль не нужна, потому что сам токен случайный.
+type RefreshTokenGenerator struct{}
+
+func NewRefreshTokenGenerator() *RefreshTokenGenerator {
+	return &RefreshTokenGenerator{}
+}
+
+func (g *RefreshTokenGenerator) Generate() (string, string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	return raw, g.Hash(raw), nil
+}
+
+func (g *RefreshTokenGenerator) Hash(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
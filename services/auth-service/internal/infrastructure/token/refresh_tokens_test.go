@@ -0,0 +1,52 @@
+package token
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestRefreshTokenGenerator(t *testing.T) {
+	g := NewRefreshTokenGenerator()
+
+	seen := make(map[string]bool)
+	for range 100 {
+		raw, hash, err := g.Generate()
+		if err != nil {
+			t.Fatalf("Generate: %v", err)
+		}
+
+		decoded, err := base64.RawURLEncoding.DecodeString(raw)
+		if err != nil || len(decoded) != refreshTokenBytes {
+			t.Fatalf("token %q decodes to %d bytes (err %v), want %d", raw, len(decoded), err, refreshTokenBytes)
+		}
+		if seen[raw] {
+			t.Fatalf("token %q generated twice", raw)
+		}
+		seen[raw] = true
+
+		if hash != g.Hash(raw) {
+			t.Fatalf("hash of %q is not stable", raw)
+		}
+		if hash == raw || len(hash) != 64 {
+			t.Fatalf("hash = %q, want hex sha256", hash)
+		}
+	}
+}
+
+func TestRefreshTokenHash(t *testing.T) {
+	g := NewRefreshTokenGenerator()
+
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{raw: "", want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{raw: "abc", want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+
+	for _, tt := range tests {
+		if got := g.Hash(tt.raw); got != tt.want {
+			t.Errorf("Hash(%q) = %s, want %s", tt.raw, got, tt.want)
+		}
+	}
+}
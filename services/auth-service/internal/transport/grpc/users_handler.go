@@ -0,0 +1,138 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/users/v1"
+
+	domain "Taskify/services/auth-service/internal/domain/user"
+	usecase "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+// Handler реализует UserServiceServer
+type Handler struct {
+	pb.UnimplementedUserServiceServer
+
+	registerUC  *usecase.RegisterUseCase
+	loginUC     *usecase.LoginUseCase
+	refreshUC   *usecase.RefreshUseCase
+	logoutUC    *usecase.LogoutUseCase
+	logoutAllUC *usecase.LogoutAllUseCase
+}
+
+func NewHandler(
+	registerUC *usecase.RegisterUseCase,
+	loginUC *usecase.LoginUseCase,
+	refreshUC *usecase.RefreshUseCase,
+	logoutUC *usecase.LogoutUseCase,
+	logoutAllUC *usecase.LogoutAllUseCase,
+) *Handler {
+	return &Handler{
+		registerUC:  registerUC,
+		loginUC:     loginUC,
+		refreshUC:   refreshUC,
+		logoutUC:    logoutUC,
+		logoutAllUC: logoutAllUC,
+	}
+}
+
+func toProtoUser(u *domain.User) *pb.User {
+	return &pb.User{
+		Id:        u.ID,
+		Email:     u.Email,
+		Username:  u.Username,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}
+}
+
+// userError переводит доменные ошибки пользователей в gRPC статусы
+func userError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEmail), errors.Is(err, domain.ErrInvalidUsername),
+		errors.Is(err, domain.ErrPasswordTooShort), errors.Is(err, domain.ErrPasswordTooLong),
+		errors.Is(err, domain.ErrEmptyUserID):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrEmailTaken), errors.Is(err, domain.ErrUsernameTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidCredentials), errors.Is(err, domain.ErrInvalidRefreshToken),
+		errors.Is(err, domain.ErrRefreshTokenReused):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	u, err := h.registerUC.Handle(ctx, usecase.RegisterCommand{
+		Email:    req.Email,
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, userError(err)
+	}
+
+	return &pb.RegisterResponse{User: toProtoUser(u)}, nil
+}
+
+func (h *Handler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	result, err := h.loginUC.Handle(ctx, usecase.LoginCommand{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, userError(err)
+	}
+
+	return &pb.LoginResponse{
+		User:                  toProtoUser(result.User),
+		AccessToken:           result.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(result.AccessTokenExpiresAt),
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(result.RefreshTokenExpiresAt),
+	}, nil
+}
+
+func (h *Handler) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	tokens, err := h.refreshUC.Handle(ctx, usecase.RefreshCommand{RefreshToken: req.RefreshToken})
+	if err != nil {
+		return nil, userError(err)
+	}
+
+	return &pb.RefreshResponse{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+	}, nil
+}
+
+func (h *Handler) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	if err := h.logoutUC.Handle(ctx, usecase.LogoutCommand{RefreshToken: req.RefreshToken}); err != nil {
+		return nil, userError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LogoutAll завершает сессии только вызывающего пользователя из metadata:
+// id в теле запроса позволил бы разлогинить кого угодно
+func (h *Handler) LogoutAll(ctx context.Context, _ *pb.LogoutAllRequest) (*pb.LogoutAllResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.logoutAllUC.Handle(ctx, usecase.LogoutAllCommand{UserID: userID})
+	if err != nil {
+		return nil, userError(err)
+	}
+
+	return &pb.LogoutAllResponse{RevokedSessions: int32(revoked)}, nil
+}
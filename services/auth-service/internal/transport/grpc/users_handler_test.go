@@ -0,0 +1,76 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "Taskify/proto/users/v1"
+
+	"Taskify/services/auth-service/internal/domain/user"
+	"Taskify/services/auth-service/internal/identity"
+	usecase "Taskify/services/auth-service/internal/usecase/auth"
+)
+
+// revokingRefreshRepo запоминает, чьи сессии отозвали; остальные методы не нужны
+type revokingRefreshRepo struct {
+	user.RefreshTokenRepository
+	revoked []int64
+}
+
+func (r *revokingRefreshRepo) RevokeAllByUser(_ context.Context, userID int64) (int, error) {
+	r.revoked = append(r.revoked, userID)
+	return 2, nil
+}
+
+func TestLogoutAll(t *testing.T) {
+	incoming := func(pairs ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+		// revoked — чьи сессии завершены; 0 — ничьи
+		revoked int64
+	}{
+		{name: "caller from gateway", ctx: incoming(identity.MetadataKey, "7"), code: codes.OK, revoked: 7},
+		{name: "no metadata", ctx: context.Background(), code: codes.Unauthenticated},
+		{name: "metadata without user", ctx: incoming("other", "7"), code: codes.Unauthenticated},
+		{name: "invalid user id", ctx: incoming(identity.MetadataKey, "abc"), code: codes.Unauthenticated},
+		{name: "zero user id", ctx: incoming(identity.MetadataKey, "0"), code: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &revokingRefreshRepo{}
+			h := NewHandler(nil, nil, nil, nil, usecase.NewLogoutAllUseCase(repo))
+
+			resp, err := IdentityInterceptor()(tt.ctx, &pb.LogoutAllRequest{}, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req any) (any, error) {
+					return h.LogoutAll(ctx, req.(*pb.LogoutAllRequest))
+				})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %v, want %v (err %v)", code, tt.code, err)
+			}
+
+			if tt.revoked == 0 {
+				if len(repo.revoked) != 0 {
+					t.Errorf("revoked sessions of %v", repo.revoked)
+				}
+				return
+			}
+			if len(repo.revoked) != 1 || repo.revoked[0] != tt.revoked {
+				t.Errorf("revoked = %v, want [%d]", repo.revoked, tt.revoked)
+			}
+			if got := resp.(*pb.LogoutAllResponse).GetRevokedSessions(); got != 2 {
+				t.Errorf("revoked sessions = %d, want 2", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type RegisterCommand struct {
+	Email    string
+	Username string
+	Password string
+}
+
+type LoginCommand struct {
+	Email    string
+	Password string
+}
+
+type RefreshCommand struct {
+	RefreshToken string
+}
+
+type LogoutCommand struct {
+	RefreshToken string
+}
+
+type LogoutAllCommand struct {
+	UserID int64
+}
+
+// Tokens — пара токенов одной сессии
+type Tokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+type LoginResult struct {
+	User *user.User
+	Tokens
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type LoginUseCase struct {
+	repo     user.Repository
+	hasher   PasswordHasher
+	sessions *SessionIssuer
+	// Хеш, с которым сверяется пароль, когда пользователя нет
+	dummyHash string
+}
+
+func NewLoginUseCase(repo user.Repository, hasher PasswordHasher, sessions *SessionIssuer) (*LoginUseCase, error) {
+	dummyHash, err := hasher.Hash("dummy-password-for-timing")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginUseCase{repo: repo, hasher: hasher, sessions: sessions, dummyHash: dummyHash}, nil
+}
+
+// Handle проверяет email и пароль и открывает новую сессию: access и refresh токены.
+// Неизвестный email и неверный пароль неотличимы ни по ошибке, ни по времени ответа:
+// для несуществующего пользователя bcrypt тоже выполняется.
+func (uc *LoginUseCase) Handle(ctx context.Context, cmd LoginCommand) (*LoginResult, error) {
+	email, err := user.NormalizeEmail(cmd.Email)
+	if err != nil {
+		return nil, user.ErrInvalidCredentials
+	}
+
+	u, err := uc.repo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			_ = uc.hasher.Compare(uc.dummyHash, cmd.Password)
+			return nil, user.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := uc.hasher.Compare(u.PasswordHash, cmd.Password); err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.sessions.Issue(ctx, u, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{User: u, Tokens: tokens}, nil
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  RegisterCommand
+		err  error
+	}{
+		{name: "new user", cmd: RegisterCommand{Email: "Alice@Example.com", Username: "alice", Password: "password1"}},
+		{name: "email taken in another case", cmd: RegisterCommand{Email: "BOB@example.com", Username: "bobby", Password: "password1"}, err: user.ErrEmailTaken},
+		{name: "username taken", cmd: RegisterCommand{Email: "other@example.com", Username: "bob", Password: "password1"}, err: user.ErrUsernameTaken},
+		{name: "short password", cmd: RegisterCommand{Email: "alice@example.com", Username: "alice", Password: "short"}, err: user.ErrPasswordTooShort},
+		{name: "invalid email", cmd: RegisterCommand{Email: "alice", Username: "alice", Password: "password1"}, err: user.ErrInvalidEmail},
+		{name: "invalid username", cmd: RegisterCommand{Email: "alice@example.com", Username: "a", Password: "password1"}, err: user.ErrInvalidUsername},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.register()
+
+			u, err := NewRegisterUseCase(f.users, f.hasher).Handle(context.Background(), tt.cmd)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if len(f.store.users) != 1 {
+					t.Errorf("users = %d after a rejected registration, want 1", len(f.store.users))
+				}
+				return
+			}
+
+			if u.ID == 0 || u.Email != "alice@example.com" {
+				t.Errorf("user = %+v", u)
+			}
+			// Пароль хранится только в виде хеша
+			if u.PasswordHash != "hash:password1" {
+				t.Errorf("password hash = %q", u.PasswordHash)
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  LoginCommand
+		err  error
+	}{
+		{name: "correct password", cmd: LoginCommand{Email: "bob@example.com", Password: "password1"}},
+		{name: "email in another case", cmd: LoginCommand{Email: " Bob@Example.com", Password: "password1"}},
+		{name: "wrong password", cmd: LoginCommand{Email: "bob@example.com", Password: "password2"}, err: user.ErrInvalidCredentials},
+		{name: "unknown email", cmd: LoginCommand{Email: "alice@example.com", Password: "password1"}, err: user.ErrInvalidCredentials},
+		{name: "malformed email", cmd: LoginCommand{Email: "bob", Password: "password1"}, err: user.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			registered := f.register()
+
+			uc, err := NewLoginUseCase(f.users, f.hasher, f.sessions)
+			if err != nil {
+				t.Fatalf("NewLoginUseCase: %v", err)
+			}
+
+			result, err := uc.Handle(context.Background(), tt.cmd)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if len(f.store.tokens) != 0 {
+					t.Errorf("refresh tokens = %d after a failed login, want 0", len(f.store.tokens))
+				}
+				return
+			}
+
+			if result.User.ID != registered.ID || result.AccessToken == "" || result.RefreshToken == "" {
+				t.Errorf("result = %+v", result)
+			}
+			if families := f.store.activeFamilies(); len(families) != 1 {
+				t.Errorf("sessions = %v, want one", families)
+			}
+		})
+	}
+}
+
+// TestLoginUnknownEmailRunsHasher: неизвестный email сверяется с пустышкой,
+// чтобы по времени ответа нельзя было узнать, зарегистрирован ли адрес
+func TestLoginUnknownEmailRunsHasher(t *testing.T) {
+	f := newFixture()
+	uc, err := NewLoginUseCase(f.users, f.hasher, f.sessions)
+	if err != nil {
+		t.Fatalf("NewLoginUseCase: %v", err)
+	}
+
+	if _, err := uc.Handle(context.Background(), LoginCommand{Email: "nobody@example.com", Password: "password1"}); !errors.Is(err, user.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+	if f.hasher.compared != 1 {
+		t.Fatalf("password compared %d times, want 1", f.hasher.compared)
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type LogoutUseCase struct {
+	tx          TxManager
+	refreshRepo user.RefreshTokenRepository
+	generator   RefreshTokenGenerator
+}
+
+func NewLogoutUseCase(tx TxManager, refreshRepo user.RefreshTokenRepository, generator RefreshTokenGenerator) *LogoutUseCase {
+	return &LogoutUseCase{tx: tx, refreshRepo: refreshRepo, generator: generator}
+}
+
+// Handle завершает сессию, которой принадлежит токен: отзывается вся семья.
+// Повторный выход или неизвестный токен — не ошибка, результат тот же.
+// Уже выданные access токены доживают свой короткий TTL.
+func (uc *LogoutUseCase) Handle(ctx context.Context, cmd LogoutCommand) error {
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := uc.refreshRepo.GetByHashForUpdate(ctx, uc.generator.Hash(cmd.RefreshToken))
+		if err != nil {
+			if errors.Is(err, user.ErrInvalidRefreshToken) {
+				return nil
+			}
+			return err
+		}
+
+		return uc.refreshRepo.RevokeFamily(ctx, current.FamilyID)
+	})
+}
+
+type LogoutAllUseCase struct {
+	refreshRepo user.RefreshTokenRepository
+}
+
+func NewLogoutAllUseCase(refreshRepo user.RefreshTokenRepository) *LogoutAllUseCase {
+	return &LogoutAllUseCase{refreshRepo: refreshRepo}
+}
+
+// Handle завершает все сессии пользователя и возвращает, сколько их было
+func (uc *LogoutAllUseCase) Handle(ctx context.Context, cmd LogoutAllCommand) (int, error) {
+	if cmd.UserID == 0 {
+		return 0, user.ErrEmptyUserID
+	}
+
+	return uc.refreshRepo.RevokeAllByUser(ctx, cmd.UserID)
+}
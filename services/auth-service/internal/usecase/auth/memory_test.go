@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+// memoryStore — хранилище пользователей и refresh токенов для тестов use case.
+// Транзакция откатывает оба набора, как это делает PostgreSQL.
+type memoryStore struct {
+	users  map[int64]user.User
+	tokens map[int64]user.RefreshToken
+	nextID int64
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{users: make(map[int64]user.User), tokens: make(map[int64]user.RefreshToken)}
+}
+
+func (s *memoryStore) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	users, tokens, nextID := maps.Clone(s.users), maps.Clone(s.tokens), s.nextID
+	if err := fn(ctx); err != nil {
+		s.users, s.tokens, s.nextID = users, tokens, nextID
+		return err
+	}
+	return nil
+}
+
+func (s *memoryStore) id() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// memoryUsers реализует user.Repository
+type memoryUsers struct{ s *memoryStore }
+
+func (r memoryUsers) Create(ctx context.Context, u *user.User) error {
+	for _, existing := range r.s.users {
+		switch {
+		case existing.Email == u.Email:
+			return user.ErrEmailTaken
+		case existing.Username == u.Username:
+			return user.ErrUsernameTaken
+		}
+	}
+
+	u.ID = r.s.id()
+	r.s.users[u.ID] = *u
+	return nil
+}
+
+func (r memoryUsers) GetByID(ctx context.Context, id int64) (*user.User, error) {
+	u, ok := r.s.users[id]
+	if !ok {
+		return nil, user.ErrUserNotFound
+	}
+	return &u, nil
+}
+
+func (r memoryUsers) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	for _, u := range r.s.users {
+		if u.Email == email {
+			return &u, nil
+		}
+	}
+	return nil, user.ErrUserNotFound
+}
+
+// memoryRefreshTokens реализует user.RefreshTokenRepository
+type memoryRefreshTokens struct{ s *memoryStore }
+
+func (r memoryRefreshTokens) Create(ctx context.Context, t *user.RefreshToken) error {
+	t.ID = r.s.id()
+	if t.FamilyID == "" {
+		t.FamilyID = fmt.Sprintf("family-%d", t.ID)
+	}
+	r.s.tokens[t.ID] = *t
+	return nil
+}
+
+func (r memoryRefreshTokens) GetByHashForUpdate(ctx context.Context, tokenHash string) (*user.RefreshToken, error) {
+	for _, t := range r.s.tokens {
+		if t.TokenHash == tokenHash {
+			return &t, nil
+		}
+	}
+	return nil, user.ErrInvalidRefreshToken
+}
+
+func (r memoryRefreshTokens) MarkUsed(ctx context.Context, id int64) error {
+	t := r.s.tokens[id]
+	now := time.Now()
+	t.UsedAt = &now
+	r.s.tokens[id] = t
+	return nil
+}
+
+func (r memoryRefreshTokens) RevokeFamily(ctx context.Context, familyID string) error {
+	now := time.Now()
+	for id, t := range r.s.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+			r.s.tokens[id] = t
+		}
+	}
+	return nil
+}
+
+func (r memoryRefreshTokens) RevokeAllByUser(ctx context.Context, userID int64) (int, error) {
+	families := make(map[string]bool)
+	now := time.Now()
+	for id, t := range r.s.tokens {
+		if t.UserID == userID && t.RevokedAt == nil {
+			families[t.FamilyID] = true
+			t.RevokedAt = &now
+			r.s.tokens[id] = t
+		}
+	}
+	return len(families), nil
+}
+
+// activeFamilies возвращает семьи, в которых есть неотозванный токен
+func (s *memoryStore) activeFamilies() []string {
+	var families []string
+	for _, t := range s.tokens {
+		if t.RevokedAt == nil && !slices.Contains(families, t.FamilyID) {
+			families = append(families, t.FamilyID)
+		}
+	}
+	slices.Sort(families)
+	return families
+}
+
+// plainHasher "хеширует" пароль приписыванием префикса и считает сравнения
+type plainHasher struct{ compared int }
+
+func (h *plainHasher) Hash(password string) (string, error) { return "hash:" + password, nil }
+
+func (h *plainHasher) Compare(hash, password string) error {
+	h.compared++
+	if hash != "hash:"+password {
+		return user.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// counterTokens выдает токены refresh-1, refresh-2, ... и access-<user id>
+type counterTokens struct{ n int }
+
+func (g *counterTokens) Generate() (string, string, error) {
+	g.n++
+	raw := fmt.Sprintf("refresh-%d", g.n)
+	return raw, g.Hash(raw), nil
+}
+
+func (g *counterTokens) Hash(raw string) string { return strings.ToUpper(raw) }
+
+func (g *counterTokens) IssueAccessToken(u *user.User) (string, time.Time, error) {
+	return fmt.Sprintf("access-%d", u.ID), time.Now().Add(time.Minute), nil
+}
+
+type fixture struct {
+	store    *memoryStore
+	users    memoryUsers
+	refresh  memoryRefreshTokens
+	hasher   *plainHasher
+	tokens   *counterTokens
+	sessions *SessionIssuer
+}
+
+func newFixture() *fixture {
+	s := newMemoryStore()
+	f := &fixture{
+		store:   s,
+		users:   memoryUsers{s},
+		refresh: memoryRefreshTokens{s},
+		hasher:  &plainHasher{},
+		tokens:  &counterTokens{},
+	}
+	f.sessions = NewSessionIssuer(f.refresh, f.tokens, f.tokens, time.Hour)
+	return f
+}
+
+// register заводит пользователя bob@example.com с паролем "password1"
+func (f *fixture) register() *user.User {
+	u, err := NewRegisterUseCase(f.users, f.hasher).Handle(context.Background(), RegisterCommand{
+		Email:    "bob@example.com",
+		Username: "bob",
+		Password: "password1",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+// PasswordHasher хеширует и сверяет пароли (security.BcryptHasher)
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Compare возвращает user.ErrInvalidCredentials, если пароль не подходит
+	Compare(hash, password string) error
+}
+
+// TokenIssuer выпускает подписанные access токены (token.JWTManager)
+type TokenIssuer interface {
+	IssueAccessToken(u *user.User) (token string, expiresAt time.Time, err error)
+}
+
+// RefreshTokenGenerator выпускает refresh токены и считает их хеш для хранения
+type RefreshTokenGenerator interface {
+	Generate() (raw, hash string, err error)
+	Hash(raw string) string
+}
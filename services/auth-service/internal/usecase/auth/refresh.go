@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type RefreshUseCase struct {
+	tx          TxManager
+	userRepo    user.Repository
+	refreshRepo user.RefreshTokenRepository
+	generator   RefreshTokenGenerator
+	sessions    *SessionIssuer
+}
+
+func NewRefreshUseCase(tx TxManager, userRepo user.Repository, refreshRepo user.RefreshTokenRepository, generator RefreshTokenGenerator, sessions *SessionIssuer) *RefreshUseCase {
+	return &RefreshUseCase{tx: tx, userRepo: userRepo, refreshRepo: refreshRepo, generator: generator, sessions: sessions}
+}
+
+// Handle обменивает refresh токен на новую пару (ротация).
+// Старый токен помечается использованным. Если использованный токен предъявили еще раз,
+// значит, его копия есть у кого-то еще: отзывается вся семья, и обе стороны теряют сессию.
+func (uc *RefreshUseCase) Handle(ctx context.Context, cmd RefreshCommand) (*Tokens, error) {
+	var (
+		tokens Tokens
+		reused *user.RefreshToken
+	)
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Блокировка строки: два параллельных обмена одного токена не пройдут оба
+		current, err := uc.refreshRepo.GetByHashForUpdate(ctx, uc.generator.Hash(cmd.RefreshToken))
+		if err != nil {
+			return err
+		}
+
+		if current.IsRevoked() || current.IsExpired(time.Now()) {
+			return user.ErrInvalidRefreshToken
+		}
+
+		if current.IsUsed() {
+			reused = current
+			// Отзыв должен закоммититься, поэтому ошибку вернем уже после транзакции
+			return uc.refreshRepo.RevokeFamily(ctx, current.FamilyID)
+		}
+
+		if err := uc.refreshRepo.MarkUsed(ctx, current.ID); err != nil {
+			return err
+		}
+
+		u, err := uc.userRepo.GetByID(ctx, current.UserID)
+		if err != nil {
+			if errors.Is(err, user.ErrUserNotFound) {
+				return user.ErrInvalidRefreshToken
+			}
+			return err
+		}
+
+		tokens, err = uc.sessions.Issue(ctx, u, current.FamilyID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reused != nil {
+		log.Warn().Int64("user_id", reused.UserID).Str("family_id", reused.FamilyID).Msg("refresh token reuse detected, family revoked")
+		return nil, user.ErrRefreshTokenReused
+	}
+
+	return &tokens, nil
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+// login открывает сессию bob и возвращает её refresh токен
+func (f *fixture) login(t *testing.T) string {
+	t.Helper()
+
+	uc, err := NewLoginUseCase(f.users, f.hasher, f.sessions)
+	if err != nil {
+		t.Fatalf("NewLoginUseCase: %v", err)
+	}
+	result, err := uc.Handle(context.Background(), LoginCommand{Email: "bob@example.com", Password: "password1"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	return result.RefreshToken
+}
+
+func TestRefresh(t *testing.T) {
+	tests := []struct {
+		name string
+		// prepare возвращает токен, который предъявит клиент
+		prepare func(t *testing.T, f *fixture, token string) string
+		err     error
+		// families — сколько сессий остается активными
+		families int
+	}{
+		{
+			name:     "rotation",
+			prepare:  func(t *testing.T, f *fixture, token string) string { return token },
+			families: 1,
+		},
+		{
+			name:     "unknown token",
+			prepare:  func(t *testing.T, f *fixture, token string) string { return "refresh-unknown" },
+			err:      user.ErrInvalidRefreshToken,
+			families: 1,
+		},
+		{
+			name: "expired token",
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				for id, stored := range f.store.tokens {
+					stored.ExpiresAt = time.Now().Add(-time.Second)
+					f.store.tokens[id] = stored
+				}
+				return token
+			},
+			err:      user.ErrInvalidRefreshToken,
+			families: 1,
+		},
+		{
+			name: "after logout",
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				if err := NewLogoutUseCase(f.store, f.refresh, f.tokens).Handle(context.Background(), LogoutCommand{RefreshToken: token}); err != nil {
+					t.Fatalf("logout: %v", err)
+				}
+				return token
+			},
+			err: user.ErrInvalidRefreshToken,
+		},
+		{
+			// Старый токен предъявили после ротации: копия утекла, отзывается вся семья
+			name: "reuse of rotated token",
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				if _, err := f.refreshUseCase().Handle(context.Background(), RefreshCommand{RefreshToken: token}); err != nil {
+					t.Fatalf("first refresh: %v", err)
+				}
+				return token
+			},
+			err: user.ErrRefreshTokenReused,
+		},
+		{
+			name: "deleted user",
+			prepare: func(t *testing.T, f *fixture, token string) string {
+				clear(f.store.users)
+				return token
+			},
+			err:      user.ErrInvalidRefreshToken,
+			families: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.register()
+			token := tt.prepare(t, f, f.login(t))
+
+			tokens, err := f.refreshUseCase().Handle(context.Background(), RefreshCommand{RefreshToken: token})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if families := f.store.activeFamilies(); len(families) != tt.families {
+				t.Fatalf("active sessions = %v, want %d", families, tt.families)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if tokens.RefreshToken == token || tokens.AccessToken == "" {
+				t.Errorf("tokens = %+v, want a new pair", tokens)
+			}
+			// Новый токен продолжает ту же сессию
+			old, _ := f.refresh.GetByHashForUpdate(context.Background(), f.tokens.Hash(token))
+			next, _ := f.refresh.GetByHashForUpdate(context.Background(), f.tokens.Hash(tokens.RefreshToken))
+			if !old.IsUsed() || next.IsUsed() || old.FamilyID != next.FamilyID {
+				t.Errorf("old = %+v, new = %+v", old, next)
+			}
+		})
+	}
+}
+
+func (f *fixture) refreshUseCase() *RefreshUseCase {
+	return NewRefreshUseCase(f.store, f.users, f.refresh, f.tokens, f.sessions)
+}
+
+func TestLogout(t *testing.T) {
+	f := newFixture()
+	f.register()
+	first, second := f.login(t), f.login(t)
+
+	logout := NewLogoutUseCase(f.store, f.refresh, f.tokens)
+	tests := []struct {
+		name  string
+		token string
+		// active — семьи, которые остаются после выхода
+		active []string
+	}{
+		{name: "ends only its own session", token: first, active: []string{"family-3"}},
+		{name: "repeated logout", token: first, active: []string{"family-3"}},
+		{name: "unknown token", token: "refresh-unknown", active: []string{"family-3"}},
+		{name: "second session", token: second, active: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := logout.Handle(context.Background(), LogoutCommand{RefreshToken: tt.token}); err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if got := f.store.activeFamilies(); !slices.Equal(got, tt.active) {
+				t.Errorf("active sessions = %v, want %v", got, tt.active)
+			}
+		})
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	f := newFixture()
+	bob := f.register()
+	f.login(t)
+	f.login(t)
+
+	uc := NewLogoutAllUseCase(f.refresh)
+	tests := []struct {
+		name    string
+		userID  int64
+		revoked int
+		err     error
+	}{
+		{name: "all sessions", userID: bob.ID, revoked: 2},
+		{name: "nothing left", userID: bob.ID, revoked: 0},
+		{name: "empty user", userID: 0, err: user.ErrEmptyUserID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revoked, err := uc.Handle(context.Background(), LogoutAllCommand{UserID: tt.userID})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if revoked != tt.revoked {
+				t.Errorf("revoked = %d, want %d", revoked, tt.revoked)
+			}
+		})
+	}
+
+	if families := f.store.activeFamilies(); len(families) != 0 {
+		t.Errorf("active sessions = %v after logout everywhere", families)
+	}
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+type RegisterUseCase struct {
+	repo   user.Repository
+	hasher PasswordHasher
+}
+
+func NewRegisterUseCase(repo user.Repository, hasher PasswordHasher) *RegisterUseCase {
+	return &RegisterUseCase{repo: repo, hasher: hasher}
+}
+
+// Handle регистрирует пользователя. Уникальность email и username
+// проверяет сама БД: предварительный SELECT не спасает от гонки двух регистраций.
+func (uc *RegisterUseCase) Handle(ctx context.Context, cmd RegisterCommand) (*user.User, error) {
+	if err := user.ValidatePassword(cmd.Password); err != nil {
+		return nil, err
+	}
+
+	// Сначала дешевые проверки, потом дорогой bcrypt
+	newUser, err := user.NewUser(cmd.Email, cmd.Username, "")
+	if err != nil {
+		return nil, err
+	}
+
+	newUser.PasswordHash, err = uc.hasher.Hash(cmd.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, newUser); err != nil {
+		return nil, err
+	}
+
+	return newUser, nil
+}
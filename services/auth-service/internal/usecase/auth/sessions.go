@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/auth-service/internal/domain/user"
+)
+
+// SessionIssuer выдает пару токенов: короткоживущий access JWT и refresh токен,
+// который сохраняется на сервере. Общий для входа и ротации.
+type SessionIssuer struct {
+	refreshRepo user.RefreshTokenRepository
+	generator   RefreshTokenGenerator
+	access      TokenIssuer
+	refreshTTL  time.Duration
+}
+
+func NewSessionIssuer(refreshRepo user.RefreshTokenRepository, generator RefreshTokenGenerator, access TokenIssuer, refreshTTL time.Duration) *SessionIssuer {
+	return &SessionIssuer{refreshRepo: refreshRepo, generator: generator, access: access, refreshTTL: refreshTTL}
+}
+
+// Issue выпускает токены для u. familyID пуст при входе (новая сессия)
+// и равен семье старого токена при ротации.
+func (s *SessionIssuer) Issue(ctx context.Context, u *user.User, familyID string) (Tokens, error) {
+	raw, hash, err := s.generator.Generate()
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	refresh := user.NewRefreshToken(u.ID, familyID, hash, s.refreshTTL)
+	if err := s.refreshRepo.Create(ctx, refresh); err != nil {
+		return Tokens{}, err
+	}
+
+	accessToken, accessExpiresAt, err := s.access.IssueAccessToken(u)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return Tokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          raw,
+		RefreshTokenExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,8 @@
+package auth
+
+import "context"
+
+// TxManager выполняет несколько обращений к репозиториям атомарно
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
@@ -24,8 +25,15 @@ import (
 	pb "Taskify/proto/boards/v1"
 
 	"Taskify/services/board-service/internal/config"
+	"Taskify/services/board-service/internal/infrastructure/cache"
+	"Taskify/services/board-service/internal/infrastructure/export"
+	"Taskify/services/board-service/internal/infrastructure/kafka"
+	"Taskify/services/board-service/internal/infrastructure/mailer"
+	"Taskify/services/board-service/internal/infrastructure/outbox"
 	"Taskify/services/board-service/internal/infrastructure/persistence"
+	"Taskify/services/board-service/internal/infrastructure/token"
 	grpcHandler "Taskify/services/board-service/internal/transport/grpc"
+	"Taskify/services/board-service/internal/transport/http/middleware"
 	httpHandler "Taskify/services/board-service/internal/transport/http/v1"
 	usecaseBoard "Taskify/services/board-service/internal/usecase/board"
 )
@@ -69,22 +77,161 @@ func main() {
 	}
 	log.Printf("Successfully connected to Database")
 
+	// Подключение к Redis (кэш структуры досок)
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     serviceConfig.Redis.Addr,
+		Password: serviceConfig.Redis.Password,
+		DB:       serviceConfig.Redis.DB,
+	})
+	defer redisClient.Close()
+
+	// Без Redis сервис работает, просто все чтения идут в БД
+	if err := redisClient.Ping(ctx).Err(); err != nil {
+		log.Warn().Err(err).Msg("Redis ping failed, board cache is unavailable")
+	}
+
 	// 3. Инициализация слоев (Dependency Injection)
 
 	// Layer 1: Persistence (Repository)
 	boardRepo := persistence.NewBoardRepository(dbPool)
+	columnRepo := persistence.NewColumnRepository(dbPool)
+	taskRepo := persistence.NewTaskRepository(dbPool)
+	userRepo := persistence.NewUserRepository(dbPool)
+	transferRepo := persistence.NewTransferRepository(dbPool)
+	memberRepo := persistence.NewMemberRepository(dbPool)
+	invitationRepo := persistence.NewInvitationRepository(dbPool)
+	outboxRepo := persistence.NewOutboxRepository(dbPool)
+	idempotencyRepo := persistence.NewIdempotencyRepository(dbPool)
+	txManager := persistence.NewTxManager(dbPool)
+	// Запись хранится в Redis и свежей, и устаревшей — отсюда сумма
+	boardCache := cache.NewBoardCache(redisClient, serviceConfig.Cache.BoardTTL+serviceConfig.Cache.BoardStaleWhileRevalidate)
 
 	// Layer 2: UseCase (Business Logic)
 	// Тут можно создать сразу структуру, которая держит все юзкейсы,
 	// но пока у нас один - инициализируем его.
-	createBoardUC := usecaseBoard.NewCreateBoardUseCase(boardRepo)
-	getBoardUC := usecaseBoard.NewGetBoardUseCase(boardRepo)
+	authorizer := usecaseBoard.NewAuthorizer(memberRepo)
+
+	idempotency := usecaseBoard.NewIdempotency(idempotencyRepo, serviceConfig.Idempotency.TTL)
+	go idempotency.RunPurge(ctx, serviceConfig.Idempotency.PurgeInterval)
+
+	createBoardUC := usecaseBoard.NewCreateBoardUseCase(txManager, boardRepo, memberRepo, outboxRepo, idempotency)
+	getBoardUC := usecaseBoard.NewGetBoardUseCase(boardRepo, boardCache, authorizer)
+	// Проверка доступа стоит снаружи кэша, чтобы выполняться и на попаданиях
+	boardStructureUC := usecaseBoard.NewAuthorizedBoardStructureReader(
+		usecaseBoard.NewCachedBoardStructureReader(
+			usecaseBoard.NewGetBoardStructureUseCase(boardRepo, columnRepo, taskRepo),
+			boardCache,
+			serviceConfig.Cache.BoardTTL,
+			serviceConfig.Cache.BoardStaleWhileRevalidate,
+		),
+		authorizer,
+	)
 	listBoardsUC := usecaseBoard.NewListBoardsUseCase(boardRepo)
-	updateBoardUC := usecaseBoard.NewUpdateBoardUseCase(boardRepo)
-	deleteBoardUC := usecaseBoard.NewDeleteBoardUseCase(boardRepo)
+	updateBoardUC := usecaseBoard.NewUpdateBoardUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+	deleteBoardUC := usecaseBoard.NewDeleteBoardUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+	moveBoardUC := usecaseBoard.NewMoveBoardUseCase(txManager, boardRepo, memberRepo, userRepo, transferRepo, outboxRepo, boardCache, authorizer)
+	archiveBoardUC := usecaseBoard.NewArchiveBoardUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+	unarchiveBoardUC := usecaseBoard.NewUnarchiveBoardUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+	restoreBoardUC := usecaseBoard.NewRestoreBoardUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+
+	cloneBoardUC := usecaseBoard.NewCloneBoardUseCase(txManager, boardRepo, columnRepo, taskRepo, memberRepo, outboxRepo, authorizer)
+	setBoardTemplateUC := usecaseBoard.NewSetBoardTemplateUseCase(txManager, boardRepo, outboxRepo, boardCache, authorizer)
+	listTemplatesUC := usecaseBoard.NewListTemplatesUseCase(boardRepo)
+
+	// Письма с приглашениями пока только пишутся в лог
+	invitationTokens := token.NewInvitationTokens()
+	invitationMailer := mailer.NewLogMailer()
+
+	// Импорт не добавляет найденных пользователей на доску, а приглашает их
+	importInviter := usecaseBoard.NewImportInviter(invitationRepo, userRepo, invitationTokens, invitationMailer, serviceConfig.Invite.TTL)
+	importTrelloUC := usecaseBoard.NewImportTrelloBoardUseCase(txManager, boardRepo, columnRepo, taskRepo, memberRepo, userRepo, outboxRepo, importInviter)
+	importBoardUC := usecaseBoard.NewImportBoardUseCase(txManager, boardRepo, columnRepo, taskRepo, memberRepo, userRepo, outboxRepo, export.NewJSONReader(), importInviter)
+	exportBoardUC := usecaseBoard.NewExportBoardUseCase(txManager, boardRepo, columnRepo, taskRepo, memberRepo, userRepo, authorizer, map[string]usecaseBoard.ExportWriter{
+		"json":     export.NewJSONWriter(),
+		"csv":      export.NewCSVWriter(),
+		"markdown": export.NewMarkdownWriter(),
+	})
+
+	// Удаленные доски окончательно стираются после срока хранения
+	purgeBoardsUC := usecaseBoard.NewPurgeDeletedBoardsUseCase(txManager, boardRepo, serviceConfig.Purge.Retention, serviceConfig.Purge.BatchSize)
+	go purgeBoardsUC.Run(ctx, serviceConfig.Purge.Interval)
+
+	createColumnUC := usecaseBoard.NewCreateColumnUseCase(txManager, boardRepo, columnRepo, outboxRepo, boardCache, authorizer)
+	renameColumnUC := usecaseBoard.NewRenameColumnUseCase(txManager, boardRepo, columnRepo, outboxRepo, boardCache, authorizer)
+	moveColumnUC := usecaseBoard.NewMoveColumnUseCase(txManager, boardRepo, columnRepo, outboxRepo, boardCache, authorizer)
+	deleteColumnUC := usecaseBoard.NewDeleteColumnUseCase(txManager, boardRepo, columnRepo, outboxRepo, boardCache, authorizer)
+
+	createTaskUC := usecaseBoard.NewCreateTaskUseCase(txManager, boardRepo, columnRepo, taskRepo, outboxRepo, boardCache, authorizer)
+	getTaskUC := usecaseBoard.NewGetTaskUseCase(boardRepo, columnRepo, taskRepo, authorizer)
+	updateTaskUC := usecaseBoard.NewUpdateTaskUseCase(txManager, boardRepo, columnRepo, taskRepo, outboxRepo, boardCache, authorizer)
+	deleteTaskUC := usecaseBoard.NewDeleteTaskUseCase(txManager, boardRepo, columnRepo, taskRepo, outboxRepo, boardCache, authorizer)
+	moveTaskUC := usecaseBoard.NewMoveTaskUseCase(txManager, boardRepo, columnRepo, taskRepo, outboxRepo, boardCache, authorizer)
+
+	listMembersUC := usecaseBoard.NewListMembersUseCase(boardRepo, memberRepo, authorizer)
+	addMemberUC := usecaseBoard.NewAddMemberUseCase(txManager, boardRepo, memberRepo, userRepo, authorizer)
+	updateMemberRoleUC := usecaseBoard.NewUpdateMemberRoleUseCase(txManager, boardRepo, memberRepo, authorizer)
+	removeMemberUC := usecaseBoard.NewRemoveMemberUseCase(txManager, boardRepo, memberRepo, authorizer)
+
+	createInvitationUC := usecaseBoard.NewCreateInvitationUseCase(txManager, boardRepo, invitationRepo, invitationTokens, invitationMailer, authorizer, serviceConfig.Invite.TTL)
+	listInvitationsUC := usecaseBoard.NewListInvitationsUseCase(boardRepo, invitationRepo, authorizer)
+	revokeInvitationUC := usecaseBoard.NewRevokeInvitationUseCase(txManager, boardRepo, invitationRepo, authorizer)
+	acceptInvitationUC := usecaseBoard.NewAcceptInvitationUseCase(txManager, invitationRepo, memberRepo, userRepo, invitationTokens, boardRepo)
 
 	// Layer 3: Transport (gRPC Handler)
-	boardHandler := grpcHandler.NewHandler(createBoardUC, getBoardUC, listBoardsUC, updateBoardUC, deleteBoardUC)
+	boardHandler := grpcHandler.NewHandler(grpcHandler.UseCases{
+		CreateBoard: createBoardUC,
+		GetBoard:    getBoardUC,
+		Structure:   boardStructureUC,
+		ListBoards:  listBoardsUC,
+		UpdateBoard: updateBoardUC,
+		DeleteBoard: deleteBoardUC,
+		MoveBoard:   moveBoardUC,
+
+		ArchiveBoard:   archiveBoardUC,
+		UnarchiveBoard: unarchiveBoardUC,
+		RestoreBoard:   restoreBoardUC,
+
+		CloneBoard:       cloneBoardUC,
+		SetBoardTemplate: setBoardTemplateUC,
+		ListTemplates:    listTemplatesUC,
+
+		ImportTrello: importTrelloUC,
+		ImportBoard:  importBoardUC,
+		ExportBoard:  exportBoardUC,
+
+		CreateColumn: createColumnUC,
+		RenameColumn: renameColumnUC,
+		MoveColumn:   moveColumnUC,
+		DeleteColumn: deleteColumnUC,
+
+		CreateTask: createTaskUC,
+		GetTask:    getTaskUC,
+		UpdateTask: updateTaskUC,
+		DeleteTask: deleteTaskUC,
+		MoveTask:   moveTaskUC,
+
+		ListMembers:      listMembersUC,
+		AddMember:        addMemberUC,
+		UpdateMemberRole: updateMemberRoleUC,
+		RemoveMember:     removeMemberUC,
+
+		CreateInvitation: createInvitationUC,
+		ListInvitations:  listInvitationsUC,
+		RevokeInvitation: revokeInvitationUC,
+		AcceptInvitation: acceptInvitationUC,
+	})
+
+	// Outbox relay: отдельная горутина перекладывает события из outbox_events в Kafka
+	eventPublisher := kafka.NewPublisher(serviceConfig.Kafka.Brokers, serviceConfig.Kafka.BoardEventsTopic)
+	defer eventPublisher.Close()
+
+	relay := outbox.NewRelay(txManager, outboxRepo, eventPublisher, outbox.Config{
+		PollInterval: serviceConfig.Outbox.PollInterval,
+		BatchSize:    serviceConfig.Outbox.BatchSize,
+		MinBackoff:   serviceConfig.Outbox.MinBackoff,
+		MaxBackoff:   serviceConfig.Outbox.MaxBackoff,
+	})
+	go relay.Run(ctx)
 
 	// 4. Запуск gRPC сервера
 	lis, err := net.Listen("tcp", serviceConfig.GRPC.Port)
@@ -92,7 +239,11 @@ func main() {
 		log.Fatal().Err(err).Msg("Failed to listen: %v")
 	}
 
-	grpcServer := grpc.NewServer()
+	// id пользователя приходит от API Gateway в metadata
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcHandler.IdentityInterceptor()),
+		grpc.StreamInterceptor(grpcHandler.IdentityStreamInterceptor()),
+	)
 
 	// Регистрируем наш сервис
 	pb.RegisterBoardServiceServer(grpcServer, boardHandler)
@@ -111,10 +262,18 @@ func main() {
 
 	// --- HTTP Server (Fiber) ---
 	app := fiber.New()
-	v1 := app.Group("/v1")
+	v1 := app.Group("/v1", middleware.JWTAuth(serviceConfig.JWT.Secret, serviceConfig.JWT.Issuer))
 
 	// Теперь httpHandler будет доступен (при условии, что ты добавил import выше)
-	httpHandler.NewBoardHandler(v1, createBoardUC, getBoardUC, listBoardsUC, updateBoardUC, deleteBoardUC)
+	httpHandler.NewBoardHandler(v1, createBoardUC, getBoardUC, boardStructureUC, listBoardsUC, updateBoardUC, deleteBoardUC, moveBoardUC)
+	httpHandler.NewBoardStateHandler(v1, archiveBoardUC, unarchiveBoardUC, restoreBoardUC)
+	httpHandler.NewTemplateHandler(v1, cloneBoardUC, setBoardTemplateUC, listTemplatesUC)
+	httpHandler.NewImportHandler(v1, importTrelloUC, importBoardUC)
+	httpHandler.NewExportHandler(v1, exportBoardUC)
+	httpHandler.NewColumnHandler(v1, createColumnUC, renameColumnUC, moveColumnUC, deleteColumnUC)
+	httpHandler.NewTaskHandler(v1, createTaskUC, getTaskUC, updateTaskUC, deleteTaskUC, moveTaskUC)
+	httpHandler.NewMemberHandler(v1, listMembersUC, addMemberUC, updateMemberRoleUC, removeMemberUC)
+	httpHandler.NewInvitationHandler(v1, createInvitationUC, listInvitationsUC, revokeInvitationUC, acceptInvitationUC)
 
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
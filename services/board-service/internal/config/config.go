@@ -9,10 +9,18 @@ import (
 )
 
 type Config struct {
-	Env      string `yaml:"env" env:"ENV" env-default:"local"` // local, dev, prod
-	Postgres PostgresConfig
-	GRPC     GRPCConfig
-	HTTP     HTTPConfig
+	Env         string `yaml:"env" env:"ENV" env-default:"local"` // local, dev, prod
+	Postgres    PostgresConfig
+	Redis       RedisConfig
+	Cache       CacheConfig
+	Kafka       KafkaConfig
+	Outbox      OutboxConfig
+	JWT         JWTConfig
+	Invite      InvitationConfig
+	Idempotency IdempotencyConfig
+	Purge       PurgeConfig
+	GRPC        GRPCConfig
+	HTTP        HTTPConfig
 }
 
 type PostgresConfig struct {
@@ -20,6 +28,59 @@ type PostgresConfig struct {
 	// Можно разбить на Host, Port, User, если нужно, но URL удобнее для pgx
 }
 
+type RedisConfig struct {
+	Addr     string `env:"REDIS_ADDR" env-default:"localhost:6379"`
+	Password string `env:"REDIS_PASSWORD"`
+	DB       int    `env:"REDIS_DB" env-default:"0"`
+}
+
+type CacheConfig struct {
+	// Сколько закэшированная структура доски считается свежей, если её не инвалидировали раньше
+	BoardTTL time.Duration `env:"BOARD_CACHE_TTL" env-default:"5m"`
+	// Сколько после BoardTTL ещё можно отдавать устаревшую структуру, пока она обновляется в фоне.
+	// 0 — stale-while-revalidate выключен
+	BoardStaleWhileRevalidate time.Duration `env:"BOARD_CACHE_STALE_WHILE_REVALIDATE" env-default:"0s"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `env:"KAFKA_BROKERS" env-separator:"," env-default:"localhost:9092"`
+	// Топик, куда релей публикует события досок
+	BoardEventsTopic string `env:"KAFKA_BOARD_EVENTS_TOPIC" env-default:"board-events"`
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" env-default:"1s"`
+	BatchSize    int           `env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	MinBackoff   time.Duration `env:"OUTBOX_MIN_BACKOFF" env-default:"1s"`
+	MaxBackoff   time.Duration `env:"OUTBOX_MAX_BACKOFF" env-default:"1m"`
+}
+
+// JWTConfig — проверка access токенов Auth Service на HTTP маршрутах
+type JWTConfig struct {
+	Secret string `env:"JWT_SECRET" env-required:"true"`
+	Issuer string `env:"JWT_ISSUER" env-default:"taskify-auth"`
+}
+
+type InvitationConfig struct {
+	// Сколько приглашение на доску можно принять после отправки
+	TTL time.Duration `env:"INVITATION_TTL" env-default:"168h"`
+}
+
+type IdempotencyConfig struct {
+	// Сколько хранится ответ операции создания по ключу идемпотентности
+	TTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" env-default:"24h"`
+	// Как часто удаляются истекшие ключи
+	PurgeInterval time.Duration `env:"IDEMPOTENCY_PURGE_INTERVAL" env-default:"1h"`
+}
+
+// PurgeConfig — окончательное удаление досок, удаленных мягко
+type PurgeConfig struct {
+	// Сколько удаленная доска хранится и может быть восстановлена
+	Retention time.Duration `env:"BOARD_DELETED_RETENTION" env-default:"720h"`
+	Interval  time.Duration `env:"BOARD_PURGE_INTERVAL" env-default:"1h"`
+	BatchSize int           `env:"BOARD_PURGE_BATCH_SIZE" env-default:"100"`
+}
+
 type GRPCConfig struct {
 	Port    string        `env:"GRPC_PORT" env-default:":50051"`
 	Timeout time.Duration `env:"GRPC_TIMEOUT" env-default:"5s"`
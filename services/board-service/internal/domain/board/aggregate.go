@@ -4,13 +4,25 @@ import (
 	"time"
 )
 
+// MaxBoardTitleLength — предел длины названия доски в символах
+const MaxBoardTitleLength = 100
+
 type Board struct {
 	ID          int64
 	Title       string
 	Description string
 	Owner       int64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// Version растет на 1 при каждом сохранении доски; по нему ловятся параллельные изменения
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// ArchivedAt — когда доску убрали в архив; nil — доска активна
+	ArchivedAt *time.Time
+	// DeletedAt — когда доску удалили. Удаленная доска хранится до очистки
+	// и пока её можно восстановить; nil — доска не удалена
+	DeletedAt *time.Time
+	// IsTemplate — доску предлагают как заготовку для новых досок через клонирование
+	IsTemplate bool
 }
 
 func NewBoard(title, description string, owner int64) (*Board, error) {
@@ -19,7 +31,7 @@ func NewBoard(title, description string, owner int64) (*Board, error) {
 	}
 
 	var runes = []rune(title)
-	if len(runes) > 100 {
+	if len(runes) > MaxBoardTitleLength {
 		return nil, ErrTitleTooLong
 	}
 
@@ -36,3 +48,86 @@ func NewBoard(title, description string, owner int64) (*Board, error) {
 		UpdatedAt:   time.Now(),
 	}, nil
 }
+
+// TransferTo передает доску новому владельцу и возвращает запись о передаче
+func (b *Board) TransferTo(newOwner, transferredBy int64) (*OwnershipTransfer, error) {
+	if newOwner == 0 {
+		return nil, ErrEmptyOwner
+	}
+
+	if transferredBy == 0 {
+		return nil, ErrEmptyTransferActor
+	}
+
+	transfer := &OwnershipTransfer{
+		BoardID:       b.ID,
+		FromUserID:    b.Owner,
+		ToUserID:      newOwner,
+		TransferredBy: transferredBy,
+		TransferredAt: time.Now(),
+	}
+
+	b.Owner = newOwner
+	b.UpdatedAt = time.Now()
+
+	return transfer, nil
+}
+
+func (b *Board) SetTemplate(isTemplate bool, now time.Time) {
+	b.IsTemplate = isTemplate
+	b.UpdatedAt = now
+}
+
+func (b *Board) IsArchived() bool {
+	return b.ArchivedAt != nil
+}
+
+func (b *Board) IsDeleted() bool {
+	return b.DeletedAt != nil
+}
+
+func (b *Board) Archive(now time.Time) error {
+	if b.IsArchived() {
+		return ErrBoardAlreadyArchived
+	}
+
+	b.ArchivedAt = &now
+	b.UpdatedAt = now
+
+	return nil
+}
+
+func (b *Board) Unarchive(now time.Time) error {
+	if !b.IsArchived() {
+		return ErrBoardNotArchived
+	}
+
+	b.ArchivedAt = nil
+	b.UpdatedAt = now
+
+	return nil
+}
+
+// SoftDelete помечает доску удаленной. Колонки, задачи и участники остаются
+// на месте, чтобы Restore вернул доску целиком
+func (b *Board) SoftDelete(now time.Time) error {
+	if b.IsDeleted() {
+		return ErrBoardNotFound
+	}
+
+	b.DeletedAt = &now
+	b.UpdatedAt = now
+
+	return nil
+}
+
+func (b *Board) Restore(now time.Time) error {
+	if !b.IsDeleted() {
+		return ErrBoardNotDeleted
+	}
+
+	b.DeletedAt = nil
+	b.UpdatedAt = now
+
+	return nil
+}
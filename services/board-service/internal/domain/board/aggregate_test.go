@@ -0,0 +1,100 @@
+package board
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBoardTransferTo(t *testing.T) {
+	tests := []struct {
+		name          string
+		newOwner      int64
+		transferredBy int64
+		err           error
+	}{
+		{name: "to another user", newOwner: 2, transferredBy: 1},
+		{name: "initiated by admin", newOwner: 2, transferredBy: 3},
+		{name: "empty owner", newOwner: 0, transferredBy: 1, err: ErrEmptyOwner},
+		{name: "empty initiator", newOwner: 2, transferredBy: 0, err: ErrEmptyTransferActor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := NewBoard("Board", "", 1)
+			b.ID = 10
+
+			transfer, err := b.TransferTo(tt.newOwner, tt.transferredBy)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if b.Owner != 1 {
+					t.Errorf("owner = %d after rejected transfer, want 1", b.Owner)
+				}
+				return
+			}
+
+			if b.Owner != tt.newOwner {
+				t.Errorf("owner = %d, want %d", b.Owner, tt.newOwner)
+			}
+			if transfer.BoardID != 10 || transfer.FromUserID != 1 || transfer.ToUserID != tt.newOwner || transfer.TransferredBy != tt.transferredBy {
+				t.Errorf("transfer = %+v", transfer)
+			}
+		})
+	}
+}
+
+func TestBoardLifecycle(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	archived := func(b *Board) { b.ArchivedAt = &earlier }
+	deleted := func(b *Board) { b.DeletedAt = &earlier }
+	active := func(b *Board) {}
+
+	tests := []struct {
+		name   string
+		state  func(b *Board)
+		action func(b *Board) error
+		err    error
+		// archived и deleted — состояние доски после действия
+		archived bool
+		deleted  bool
+	}{
+		{name: "archive", state: active, action: func(b *Board) error { return b.Archive(now) }, archived: true},
+		{name: "archive twice", state: archived, action: func(b *Board) error { return b.Archive(now) }, err: ErrBoardAlreadyArchived, archived: true},
+		{name: "unarchive", state: archived, action: func(b *Board) error { return b.Unarchive(now) }},
+		{name: "unarchive active", state: active, action: func(b *Board) error { return b.Unarchive(now) }, err: ErrBoardNotArchived},
+		{name: "delete", state: active, action: func(b *Board) error { return b.SoftDelete(now) }, deleted: true},
+		// Архив не мешает удалению и переживает восстановление
+		{name: "delete archived", state: archived, action: func(b *Board) error { return b.SoftDelete(now) }, archived: true, deleted: true},
+		{name: "delete twice", state: deleted, action: func(b *Board) error { return b.SoftDelete(now) }, err: ErrBoardNotFound, deleted: true},
+		{name: "restore", state: deleted, action: func(b *Board) error { return b.Restore(now) }},
+		{name: "restore active", state: active, action: func(b *Board) error { return b.Restore(now) }, err: ErrBoardNotDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Board{ID: 1, Title: "Board", Owner: 1, UpdatedAt: earlier}
+			tt.state(b)
+
+			err := tt.action(b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if b.IsArchived() != tt.archived || b.IsDeleted() != tt.deleted {
+				t.Errorf("archived = %v, deleted = %v, want %v, %v", b.IsArchived(), b.IsDeleted(), tt.archived, tt.deleted)
+			}
+
+			// Неудачное действие не трогает время изменения
+			want := now
+			if tt.err != nil {
+				want = earlier
+			}
+			if !b.UpdatedAt.Equal(want) {
+				t.Errorf("updated at = %v, want %v", b.UpdatedAt, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package board
+
+import (
+	"time"
+)
+
+// MaxColumnTitleLength — предел длины названия колонки в символах
+const MaxColumnTitleLength = 100
+
+// Column — колонка доски. Position задает порядок колонок внутри доски
+// и всегда лежит в диапазоне [0, количество колонок).
+type Column struct {
+	ID        int64
+	BoardID   int64
+	Title     string
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewColumn(boardID int64, title string, position int) (*Column, error) {
+	if err := validateColumnTitle(title); err != nil {
+		return nil, err
+	}
+
+	if position < 0 {
+		return nil, ErrInvalidPosition
+	}
+
+	return &Column{
+		BoardID:   boardID,
+		Title:     title,
+		Position:  position,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (c *Column) Rename(title string) error {
+	if err := validateColumnTitle(title); err != nil {
+		return err
+	}
+
+	c.Title = title
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func validateColumnTitle(title string) error {
+	if title == "" {
+		return ErrColumnTitleRequired
+	}
+
+	if len([]rune(title)) > MaxColumnTitleLength {
+		return ErrColumnTitleTooLong
+	}
+
+	return nil
+}
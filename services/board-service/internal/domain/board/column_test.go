@@ -0,0 +1,62 @@
+package board
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		position int
+		err      error
+	}{
+		{name: "valid", title: "Todo", position: 0},
+		{name: "title at the limit", title: strings.Repeat("я", MaxColumnTitleLength), position: 3},
+		{name: "empty title", title: "", position: 0, err: ErrColumnTitleRequired},
+		{name: "title too long", title: strings.Repeat("я", MaxColumnTitleLength+1), position: 0, err: ErrColumnTitleTooLong},
+		{name: "negative position", title: "Todo", position: -1, err: ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewColumn(7, tt.title, tt.position)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if c.BoardID != 7 || c.Title != tt.title || c.Position != tt.position {
+				t.Errorf("column = %+v", c)
+			}
+		})
+	}
+}
+
+func TestColumnRename(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+		err   error
+	}{
+		{name: "valid", title: "Done", want: "Done"},
+		{name: "empty title", title: "", want: "Todo", err: ErrColumnTitleRequired},
+		{name: "title too long", title: strings.Repeat("a", MaxColumnTitleLength+1), want: "Todo", err: ErrColumnTitleTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := NewColumn(1, "Todo", 0)
+			if err := c.Rename(tt.title); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if c.Title != tt.want {
+				t.Errorf("title = %q, want %q", c.Title, tt.want)
+			}
+		})
+	}
+}
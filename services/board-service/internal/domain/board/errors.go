@@ -7,4 +7,51 @@ var (
 	ErrTitleRequired = errors.New("board title is required")
 	ErrTitleTooLong  = errors.New("board title is too long")
 	ErrEmptyOwner    = errors.New("owner is empty")
+	ErrForbidden     = errors.New("access to board is forbidden")
+
+	ErrInvalidSort      = errors.New("invalid sort field")
+	ErrInvalidPageSize  = errors.New("invalid page size")
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	ErrVersionConflict = errors.New("board was modified by another request")
+
+	ErrBoardAlreadyArchived = errors.New("board is already archived")
+	ErrBoardNotArchived     = errors.New("board is not archived")
+	ErrBoardNotDeleted      = errors.New("board is not deleted")
+	ErrBoardArchived        = errors.New("board is archived")
+	ErrInvalidArchiveFilter = errors.New("invalid archive filter")
+
+	ErrInvalidImport       = errors.New("invalid import file")
+	ErrInvalidExportFormat = errors.New("invalid export format")
+	// Документ выгружен более новой версией Taskify с несовместимой схемой
+	ErrUnsupportedDocumentVersion = errors.New("unsupported board document version")
+
+	ErrInvalidIdempotencyKey = errors.New("idempotency key is too long")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was already used with another request")
+
+	ErrUserNotFound       = errors.New("user not found")
+	ErrEmptyTransferActor = errors.New("transfer initiator is empty")
+
+	ErrColumnNotFound      = errors.New("column not found")
+	ErrColumnTitleRequired = errors.New("column title is required")
+	ErrColumnTitleTooLong  = errors.New("column title is too long")
+	ErrInvalidPosition     = errors.New("invalid position")
+
+	ErrTaskNotFound      = errors.New("task not found")
+	ErrTaskTitleRequired = errors.New("task title is required")
+	ErrTaskTitleTooLong  = errors.New("task title is too long")
+	ErrAssigneeNotFound  = errors.New("assignee not found")
+
+	ErrMemberNotFound      = errors.New("board member not found")
+	ErrMemberAlreadyExists = errors.New("user is already a board member")
+	ErrEmptyMember         = errors.New("member user is empty")
+	ErrInvalidRole         = errors.New("invalid member role")
+	ErrOwnerRoleImmutable  = errors.New("owner role can only change by board transfer")
+
+	ErrInvalidEmail            = errors.New("invalid email")
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationExpired       = errors.New("invitation has expired")
+	ErrInvitationRevoked       = errors.New("invitation has been revoked")
+	ErrInvitationAlreadyUsed   = errors.New("invitation has already been accepted")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to another email")
 )
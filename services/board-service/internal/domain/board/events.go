@@ -1 +1,224 @@
 package board
+
+import (
+	"time"
+)
+
+type EventType string
+
+const (
+	EventBoardCreated EventType = "BoardCreated"
+	EventBoardUpdated EventType = "BoardUpdated"
+	EventBoardDeleted EventType = "BoardDeleted"
+	EventBoardMoved   EventType = "BoardMoved"
+
+	EventBoardArchived   EventType = "BoardArchived"
+	EventBoardUnarchived EventType = "BoardUnarchived"
+	EventBoardRestored   EventType = "BoardRestored"
+
+	EventBoardCloned   EventType = "BoardCloned"
+	EventBoardImported EventType = "BoardImported"
+
+	EventColumnCreated EventType = "ColumnCreated"
+	EventColumnRenamed EventType = "ColumnRenamed"
+	EventColumnMoved   EventType = "ColumnMoved"
+	EventColumnDeleted EventType = "ColumnDeleted"
+
+	EventTaskCreated EventType = "TaskCreated"
+	EventTaskUpdated EventType = "TaskUpdated"
+	EventTaskMoved   EventType = "TaskMoved"
+	EventTaskDeleted EventType = "TaskDeleted"
+)
+
+// Event — доменное событие. Все события привязаны к доске: по BoardID
+// их упорядочивают при публикации. Payload сериализуется в JSON как есть.
+type Event struct {
+	Type       EventType
+	BoardID    int64
+	Payload    any
+	OccurredAt time.Time
+}
+
+type BoardPayload struct {
+	BoardID     int64  `json:"boardId"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	OwnerID     int64  `json:"ownerId"`
+}
+
+type BoardMovedPayload struct {
+	BoardID     int64 `json:"boardId"`
+	FromOwnerID int64 `json:"fromOwnerId"`
+	ToOwnerID   int64 `json:"toOwnerId"`
+	MovedBy     int64 `json:"movedBy"`
+}
+
+// BoardClonedPayload — копия доски описывается одним событием, а не событием
+// на каждую колонку и задачу: иначе получатели приняли бы клонирование
+// за пачку новых задач
+type BoardClonedPayload struct {
+	BoardPayload
+	SourceBoardID int64 `json:"sourceBoardId"`
+	Columns       int   `json:"columns"`
+	Tasks         int   `json:"tasks"`
+}
+
+// BoardImportedPayload — как и копия, импорт описывается одним событием.
+// Source — откуда импортирована доска: trello или taskify
+type BoardImportedPayload struct {
+	BoardPayload
+	Source  string `json:"source"`
+	Columns int    `json:"columns"`
+	Tasks   int    `json:"tasks"`
+}
+
+type ColumnPayload struct {
+	BoardID  int64  `json:"boardId"`
+	ColumnID int64  `json:"columnId"`
+	Title    string `json:"title"`
+	Position int    `json:"position"`
+}
+
+type TaskPayload struct {
+	BoardID int64 `json:"boardId"`
+	// Владелец доски нужен получателям событий, чтобы знать, кого уведомлять
+	BoardOwnerID int64  `json:"boardOwnerId"`
+	ColumnID     int64  `json:"columnId"`
+	TaskID       int64  `json:"taskId"`
+	Title        string `json:"title"`
+	AssigneeID   int64  `json:"assigneeId"`
+	Position     int    `json:"position"`
+}
+
+type TaskMovedPayload struct {
+	TaskPayload
+	FromColumnID int64 `json:"fromColumnId"`
+	FromPosition int   `json:"fromPosition"`
+}
+
+func newEvent(eventType EventType, boardID int64, payload any) Event {
+	return Event{
+		Type:       eventType,
+		BoardID:    boardID,
+		Payload:    payload,
+		OccurredAt: time.Now(),
+	}
+}
+
+func boardPayload(b *Board) BoardPayload {
+	return BoardPayload{
+		BoardID:     b.ID,
+		Title:       b.Title,
+		Description: b.Description,
+		OwnerID:     b.Owner,
+	}
+}
+
+func NewBoardCreated(b *Board) Event {
+	return newEvent(EventBoardCreated, b.ID, boardPayload(b))
+}
+
+func NewBoardUpdated(b *Board) Event {
+	return newEvent(EventBoardUpdated, b.ID, boardPayload(b))
+}
+
+func NewBoardDeleted(boardID int64) Event {
+	return newEvent(EventBoardDeleted, boardID, BoardPayload{BoardID: boardID})
+}
+
+func NewBoardArchived(b *Board) Event {
+	return newEvent(EventBoardArchived, b.ID, boardPayload(b))
+}
+
+func NewBoardUnarchived(b *Board) Event {
+	return newEvent(EventBoardUnarchived, b.ID, boardPayload(b))
+}
+
+func NewBoardRestored(b *Board) Event {
+	return newEvent(EventBoardRestored, b.ID, boardPayload(b))
+}
+
+func NewBoardCloned(b *Board, sourceID int64, columns, tasks int) Event {
+	return newEvent(EventBoardCloned, b.ID, BoardClonedPayload{
+		BoardPayload:  boardPayload(b),
+		SourceBoardID: sourceID,
+		Columns:       columns,
+		Tasks:         tasks,
+	})
+}
+
+func NewBoardImported(b *Board, source string, columns, tasks int) Event {
+	return newEvent(EventBoardImported, b.ID, BoardImportedPayload{
+		BoardPayload: boardPayload(b),
+		Source:       source,
+		Columns:      columns,
+		Tasks:        tasks,
+	})
+}
+
+func NewBoardMoved(t *OwnershipTransfer) Event {
+	return newEvent(EventBoardMoved, t.BoardID, BoardMovedPayload{
+		BoardID:     t.BoardID,
+		FromOwnerID: t.FromUserID,
+		ToOwnerID:   t.ToUserID,
+		MovedBy:     t.TransferredBy,
+	})
+}
+
+func columnPayload(c *Column) ColumnPayload {
+	return ColumnPayload{
+		BoardID:  c.BoardID,
+		ColumnID: c.ID,
+		Title:    c.Title,
+		Position: c.Position,
+	}
+}
+
+func NewColumnCreated(c *Column) Event {
+	return newEvent(EventColumnCreated, c.BoardID, columnPayload(c))
+}
+
+func NewColumnRenamed(c *Column) Event {
+	return newEvent(EventColumnRenamed, c.BoardID, columnPayload(c))
+}
+
+func NewColumnMoved(c *Column) Event {
+	return newEvent(EventColumnMoved, c.BoardID, columnPayload(c))
+}
+
+func NewColumnDeleted(c *Column) Event {
+	return newEvent(EventColumnDeleted, c.BoardID, columnPayload(c))
+}
+
+// У задачи нет прямой ссылки на доску, поэтому доска передается явно
+func taskPayload(b *Board, t *Task) TaskPayload {
+	return TaskPayload{
+		BoardID:      b.ID,
+		BoardOwnerID: b.Owner,
+		ColumnID:     t.ColumnID,
+		TaskID:       t.ID,
+		Title:        t.Title,
+		AssigneeID:   t.AssigneeID,
+		Position:     t.Position,
+	}
+}
+
+func NewTaskCreated(b *Board, t *Task) Event {
+	return newEvent(EventTaskCreated, b.ID, taskPayload(b, t))
+}
+
+func NewTaskUpdated(b *Board, t *Task) Event {
+	return newEvent(EventTaskUpdated, b.ID, taskPayload(b, t))
+}
+
+func NewTaskMoved(b *Board, t *Task, fromColumnID int64, fromPosition int) Event {
+	return newEvent(EventTaskMoved, b.ID, TaskMovedPayload{
+		TaskPayload:  taskPayload(b, t),
+		FromColumnID: fromColumnID,
+		FromPosition: fromPosition,
+	})
+}
+
+func NewTaskDeleted(b *Board, t *Task) Event {
+	return newEvent(EventTaskDeleted, b.ID, taskPayload(b, t))
+}
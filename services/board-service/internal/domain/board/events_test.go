@@ -0,0 +1,79 @@
+package board
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEventPayloads(t *testing.T) {
+	b := &Board{ID: 1, Title: "Board", Description: "About", Owner: 7}
+	column := &Column{ID: 2, BoardID: 1, Title: "Todo", Position: 0}
+	task := &Task{ID: 3, ColumnID: 2, Title: "Task", AssigneeID: 8, Position: 4}
+
+	tests := []struct {
+		name  string
+		event Event
+		typ   EventType
+		json  string
+	}{
+		{
+			name:  "board created",
+			event: NewBoardCreated(b),
+			typ:   EventBoardCreated,
+			json:  `{"boardId":1,"title":"Board","description":"About","ownerId":7}`,
+		},
+		{
+			name:  "board deleted",
+			event: NewBoardDeleted(1),
+			typ:   EventBoardDeleted,
+			json:  `{"boardId":1,"title":"","description":"","ownerId":0}`,
+		},
+		{
+			name:  "board moved",
+			event: NewBoardMoved(&OwnershipTransfer{BoardID: 1, FromUserID: 7, ToUserID: 9, TransferredBy: 7}),
+			typ:   EventBoardMoved,
+			json:  `{"boardId":1,"fromOwnerId":7,"toOwnerId":9,"movedBy":7}`,
+		},
+		{
+			name:  "column created",
+			event: NewColumnCreated(column),
+			typ:   EventColumnCreated,
+			json:  `{"boardId":1,"columnId":2,"title":"Todo","position":0}`,
+		},
+		{
+			name:  "task created",
+			event: NewTaskCreated(b, task),
+			typ:   EventTaskCreated,
+			json:  `{"boardId":1,"boardOwnerId":7,"columnId":2,"taskId":3,"title":"Task","assigneeId":8,"position":4}`,
+		},
+		{
+			name:  "task moved",
+			event: NewTaskMoved(b, task, 5, 1),
+			typ:   EventTaskMoved,
+			json:  `{"boardId":1,"boardOwnerId":7,"columnId":2,"taskId":3,"title":"Task","assigneeId":8,"position":4,"fromColumnId":5,"fromPosition":1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.event.Type != tt.typ {
+				t.Errorf("type = %s, want %s", tt.event.Type, tt.typ)
+			}
+			// Все события привязаны к доске: по ней их упорядочивает outbox
+			if tt.event.BoardID != 1 {
+				t.Errorf("board = %d, want 1", tt.event.BoardID)
+			}
+			if tt.event.OccurredAt.IsZero() {
+				t.Errorf("occurred at is zero")
+			}
+
+			payload, err := json.Marshal(tt.event.Payload)
+			if err != nil {
+				t.Fatalf("marshal payload: %v", err)
+			}
+			if string(payload) != tt.json {
+				t.Errorf("payload = %s, want %s", payload, tt.json)
+			}
+		})
+	}
+}
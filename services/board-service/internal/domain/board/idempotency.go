@@ -0,0 +1,30 @@
+package board
+
+import (
+	"time"
+)
+
+// MaxIdempotencyKeyLength — ограничение длины ключа, присланного клиентом
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyRecord — запомненный результат операции создания.
+// Ключ уникален в пределах пользователя и операции: разные клиенты
+// могут случайно выбрать одинаковые ключи, и это не должно их смешивать.
+type IdempotencyRecord struct {
+	UserID    int64
+	Operation string
+	Key       string
+	// Хэш тела запроса: тот же ключ с другим запросом — ошибка клиента
+	RequestHash string
+	// Ответ первого выполнения; пуст, пока операция не завершилась
+	Response  []byte
+	ExpiresAt time.Time
+}
+
+func ValidateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return ErrInvalidIdempotencyKey
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package board
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		err  error
+	}{
+		{name: "empty", key: ""},
+		{name: "uuid", key: "4f7d1c2e-8a3b-4e6f-9d0c-1b2a3c4d5e6f"},
+		{name: "at the limit", key: strings.Repeat("k", MaxIdempotencyKeyLength)},
+		{name: "too long", key: strings.Repeat("k", MaxIdempotencyKeyLength+1), err: ErrInvalidIdempotencyKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIdempotencyKey(tt.key); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
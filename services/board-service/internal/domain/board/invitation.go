@@ -0,0 +1,112 @@
+package board
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Invitation — приглашение на доску по email. Сам токен не хранится:
+// в БД лежит только его хэш, а токен уходит приглашенному письмом.
+type Invitation struct {
+	ID        int64
+	BoardID   int64
+	Email     string
+	Role      Role
+	TokenHash string
+	InvitedBy int64
+	ExpiresAt time.Time
+	// Заполняется, когда приглашение принято
+	AcceptedAt *time.Time
+	AcceptedBy int64
+	// Заполняется, когда приглашение отозвано
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewInvitation создает приглашение, действующее ttl с момента создания.
+// Роль owner выдать приглашением нельзя.
+func NewInvitation(boardID int64, email string, role Role, invitedBy int64, tokenHash string, ttl time.Duration) (*Invitation, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.valid() {
+		return nil, ErrInvalidRole
+	}
+
+	if role == RoleOwner {
+		return nil, ErrOwnerRoleImmutable
+	}
+
+	now := time.Now()
+
+	return &Invitation{
+		BoardID:   boardID,
+		Email:     email,
+		Role:      role,
+		TokenHash: tokenHash,
+		InvitedBy: invitedBy,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+// NormalizeEmail проверяет адрес и приводит его к нижнему регистру —
+// так же, как это делает сервис авторизации при регистрации
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+
+	return email, nil
+}
+
+// Pending сообщает, можно ли еще принять или отозвать приглашение
+func (i *Invitation) Pending(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
+
+// Accept отмечает приглашение принятым. Принять можно только один раз
+// и только пользователю с тем адресом, на который ушло приглашение.
+func (i *Invitation) Accept(userID int64, email string, now time.Time) error {
+	if err := i.checkPending(now); err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(email), i.Email) {
+		return ErrInvitationEmailMismatch
+	}
+
+	i.AcceptedAt = &now
+	i.AcceptedBy = userID
+
+	return nil
+}
+
+func (i *Invitation) Revoke(now time.Time) error {
+	if err := i.checkPending(now); err != nil {
+		return err
+	}
+
+	i.RevokedAt = &now
+
+	return nil
+}
+
+func (i *Invitation) checkPending(now time.Time) error {
+	switch {
+	case i.AcceptedAt != nil:
+		return ErrInvitationAlreadyUsed
+	case i.RevokedAt != nil:
+		return ErrInvitationRevoked
+	case !now.Before(i.ExpiresAt):
+		return ErrInvitationExpired
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package board
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{in: "bob@example.com", want: "bob@example.com"},
+		{in: "  Bob@Example.COM ", want: "bob@example.com"},
+		{in: "", err: ErrInvalidEmail},
+		{in: "bob", err: ErrInvalidEmail},
+		{in: "bob@", err: ErrInvalidEmail},
+		// Адрес с именем парсится, но это не голый email
+		{in: "Bob <bob@example.com>", err: ErrInvalidEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := NormalizeEmail(tt.in)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("NormalizeEmail(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestNewInvitation(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		role  Role
+		want  string
+		err   error
+	}{
+		{name: "editor", email: "bob@example.com", role: RoleEditor, want: "bob@example.com"},
+		{name: "email is normalized", email: " BOB@example.com", role: RoleViewer, want: "bob@example.com"},
+		{name: "admin", email: "bob@example.com", role: RoleAdmin, want: "bob@example.com"},
+		{name: "owner role", email: "bob@example.com", role: RoleOwner, err: ErrOwnerRoleImmutable},
+		{name: "unknown role", email: "bob@example.com", role: Role("guest"), err: ErrInvalidRole},
+		{name: "invalid email", email: "bob", role: RoleEditor, err: ErrInvalidEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now()
+
+			inv, err := NewInvitation(7, tt.email, tt.role, 1, "hash", time.Hour)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if inv.BoardID != 7 || inv.Email != tt.want || inv.Role != tt.role || inv.InvitedBy != 1 || inv.TokenHash != "hash" {
+				t.Errorf("invitation = %+v", inv)
+			}
+			if inv.ExpiresAt.Sub(before) < time.Hour || !inv.Pending(before) {
+				t.Errorf("expires at %v, want an hour after %v", inv.ExpiresAt, before)
+			}
+		})
+	}
+}
+
+func TestInvitationAccept(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		state func(i *Invitation)
+		email string
+		err   error
+	}{
+		{name: "pending", state: func(i *Invitation) {}, email: "bob@example.com"},
+		{name: "email in another case", state: func(i *Invitation) {}, email: " Bob@Example.com"},
+		{name: "another email", state: func(i *Invitation) {}, email: "eve@example.com", err: ErrInvitationEmailMismatch},
+		{name: "expired", state: func(i *Invitation) { i.ExpiresAt = earlier }, email: "bob@example.com", err: ErrInvitationExpired},
+		{name: "expires right now", state: func(i *Invitation) { i.ExpiresAt = now }, email: "bob@example.com", err: ErrInvitationExpired},
+		{name: "already accepted", state: func(i *Invitation) { i.AcceptedAt, i.AcceptedBy = &earlier, 3 }, email: "bob@example.com", err: ErrInvitationAlreadyUsed},
+		{name: "revoked", state: func(i *Invitation) { i.RevokedAt = &earlier }, email: "bob@example.com", err: ErrInvitationRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Invitation{BoardID: 7, Email: "bob@example.com", Role: RoleEditor, ExpiresAt: now.Add(time.Hour)}
+			tt.state(inv)
+			acceptedBy := inv.AcceptedBy
+
+			err := inv.Accept(2, tt.email, now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if inv.AcceptedBy != acceptedBy {
+					t.Errorf("accepted by %d after failed accept", inv.AcceptedBy)
+				}
+				return
+			}
+			if inv.AcceptedAt == nil || !inv.AcceptedAt.Equal(now) || inv.AcceptedBy != 2 || inv.Pending(now) {
+				t.Errorf("invitation = %+v, want accepted by 2", inv)
+			}
+		})
+	}
+}
+
+func TestInvitationRevoke(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		state func(i *Invitation)
+		err   error
+	}{
+		{name: "pending", state: func(i *Invitation) {}},
+		{name: "expired", state: func(i *Invitation) { i.ExpiresAt = earlier }, err: ErrInvitationExpired},
+		{name: "accepted", state: func(i *Invitation) { i.AcceptedAt = &earlier }, err: ErrInvitationAlreadyUsed},
+		{name: "revoked twice", state: func(i *Invitation) { i.RevokedAt = &earlier }, err: ErrInvitationRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Invitation{Email: "bob@example.com", Role: RoleEditor, ExpiresAt: now.Add(time.Hour)}
+			tt.state(inv)
+
+			err := inv.Revoke(now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if inv.Pending(now) {
+				t.Error("invitation is still pending")
+			}
+		})
+	}
+}
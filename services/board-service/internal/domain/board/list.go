@@ -0,0 +1,79 @@
+package board
+
+import (
+	"time"
+)
+
+// SortField — поле, по которому сортируется список досок
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+)
+
+func ParseSortField(s string) (SortField, error) {
+	switch field := SortField(s); field {
+	case "":
+		return SortByCreatedAt, nil
+	case SortByCreatedAt, SortByUpdatedAt:
+		return field, nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// ArchiveFilter — какие доски попадают в список по состоянию архива.
+// Удаленные доски видны только с фильтром deleted
+type ArchiveFilter string
+
+const (
+	ArchiveFilterActive   ArchiveFilter = "active"
+	ArchiveFilterArchived ArchiveFilter = "archived"
+	ArchiveFilterAll      ArchiveFilter = "all"
+	// ArchiveFilterDeleted — удаленные, но еще не очищенные доски, которыми владеет
+	// пользователь: только их он может восстановить
+	ArchiveFilterDeleted ArchiveFilter = "deleted"
+)
+
+func ParseArchiveFilter(s string) (ArchiveFilter, error) {
+	switch filter := ArchiveFilter(s); filter {
+	case "":
+		return ArchiveFilterActive, nil
+	case ArchiveFilterActive, ArchiveFilterArchived, ArchiveFilterAll, ArchiveFilterDeleted:
+		return filter, nil
+	default:
+		return "", ErrInvalidArchiveFilter
+	}
+}
+
+// ListCursor — позиция в отсортированном списке: значение поля сортировки
+// и id последней отданной доски. id разрешает равенство временных меток.
+type ListCursor struct {
+	Value time.Time
+	ID    int64
+}
+
+// ListQuery — запрос страницы досок, доступных пользователю
+type ListQuery struct {
+	// Доски, где пользователь владелец или участник
+	UserID int64
+	// Подстрока названия без учета регистра, пустая — без фильтра
+	Search     string
+	Archived   ArchiveFilter
+	SortBy     SortField
+	Descending bool
+	// nil — первая страница
+	After *ListCursor
+	Limit int
+}
+
+// CursorOf возвращает курсор, указывающий на доску b в порядке сортировки field
+func CursorOf(b *Board, field SortField) ListCursor {
+	value := b.CreatedAt
+	if field == SortByUpdatedAt {
+		value = b.UpdatedAt
+	}
+
+	return ListCursor{Value: value, ID: b.ID}
+}
@@ -0,0 +1,77 @@
+package board
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSortField(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SortField
+		err  error
+	}{
+		{in: "", want: SortByCreatedAt},
+		{in: "created_at", want: SortByCreatedAt},
+		{in: "updated_at", want: SortByUpdatedAt},
+		{in: "title", err: ErrInvalidSort},
+		{in: "CREATED_AT", err: ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSortField(tt.in)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("ParseSortField(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseArchiveFilter(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ArchiveFilter
+		err  error
+	}{
+		{in: "", want: ArchiveFilterActive},
+		{in: "active", want: ArchiveFilterActive},
+		{in: "archived", want: ArchiveFilterArchived},
+		{in: "all", want: ArchiveFilterAll},
+		{in: "deleted", want: ArchiveFilterDeleted},
+		{in: "trash", err: ErrInvalidArchiveFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseArchiveFilter(tt.in)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("ParseArchiveFilter(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestCursorOf(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	b := &Board{ID: 7, CreatedAt: created, UpdatedAt: updated}
+
+	tests := []struct {
+		field SortField
+		want  time.Time
+	}{
+		{field: SortByCreatedAt, want: created},
+		{field: SortByUpdatedAt, want: updated},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.field), func(t *testing.T) {
+			got := CursorOf(b, tt.field)
+			if !got.Value.Equal(tt.want) || got.ID != 7 {
+				t.Errorf("CursorOf = %+v, want %v of board 7", got, tt.want)
+			}
+		})
+	}
+}
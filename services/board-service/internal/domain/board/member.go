@@ -0,0 +1,125 @@
+package board
+
+import (
+	"time"
+)
+
+// Role — роль участника доски. Роли упорядочены по убыванию прав:
+// owner > admin > editor > viewer.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+// Permission — действие над доской, которое проверяется по роли
+type Permission int
+
+const (
+	// PermissionRead — чтение доски, колонок и задач
+	PermissionRead Permission = iota
+	// PermissionEdit — изменение доски, колонок и задач
+	PermissionEdit
+	// PermissionManageMembers — добавление и удаление участников, смена ролей
+	PermissionManageMembers
+	// PermissionArchive — перенос доски в архив и обратно
+	PermissionArchive
+	// PermissionDelete — удаление доски и передача её другому владельцу
+	PermissionDelete
+)
+
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if !role.valid() {
+		return "", ErrInvalidRole
+	}
+
+	return role, nil
+}
+
+func (r Role) valid() bool {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleEditor, RoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows сообщает, разрешено ли роли действие
+func (r Role) Allows(p Permission) bool {
+	switch p {
+	case PermissionRead:
+		return r.valid()
+	case PermissionEdit:
+		return r == RoleOwner || r == RoleAdmin || r == RoleEditor
+	case PermissionManageMembers, PermissionArchive:
+		return r == RoleOwner || r == RoleAdmin
+	case PermissionDelete:
+		return r == RoleOwner
+	default:
+		return false
+	}
+}
+
+// CanAssign сообщает, может ли участник с ролью r выдать или отобрать роль target.
+// Роль owner не выдается вовсе — владелец меняется только передачей доски.
+// Админ управляет редакторами и зрителями, но не другими админами.
+func (r Role) CanAssign(target Role) bool {
+	switch target {
+	case RoleAdmin:
+		return r == RoleOwner
+	case RoleEditor, RoleViewer:
+		return r == RoleOwner || r == RoleAdmin
+	default:
+		return false
+	}
+}
+
+// Member — участник доски. Владелец доски тоже хранится участником с ролью owner,
+// чтобы список участников и доступные пользователю доски читались из одной таблицы.
+type Member struct {
+	BoardID   int64
+	UserID    int64
+	Role      Role
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewMember(boardID, userID int64, role Role) (*Member, error) {
+	if userID == 0 {
+		return nil, ErrEmptyMember
+	}
+
+	if !role.valid() {
+		return nil, ErrInvalidRole
+	}
+
+	return &Member{
+		BoardID:   boardID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// ChangeRole меняет роль участника. Роль владельца нельзя ни выдать, ни снять —
+// для этого есть передача доски.
+func (m *Member) ChangeRole(role Role) error {
+	if !role.valid() {
+		return ErrInvalidRole
+	}
+
+	if m.Role == RoleOwner || role == RoleOwner {
+		return ErrOwnerRoleImmutable
+	}
+
+	m.Role = role
+	m.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package board
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Role
+		err  error
+	}{
+		{in: "owner", want: RoleOwner},
+		{in: "admin", want: RoleAdmin},
+		{in: "editor", want: RoleEditor},
+		{in: "viewer", want: RoleViewer},
+		{in: "", err: ErrInvalidRole},
+		{in: "Admin", err: ErrInvalidRole},
+		{in: "guest", err: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRole(tt.in)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("ParseRole(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	permissions := []struct {
+		name string
+		p    Permission
+	}{
+		{"read", PermissionRead},
+		{"edit", PermissionEdit},
+		{"manage members", PermissionManageMembers},
+		{"archive", PermissionArchive},
+		{"delete", PermissionDelete},
+	}
+
+	// Права перечислены в порядке permissions
+	tests := []struct {
+		role Role
+		want []bool
+	}{
+		{role: RoleOwner, want: []bool{true, true, true, true, true}},
+		{role: RoleAdmin, want: []bool{true, true, true, true, false}},
+		{role: RoleEditor, want: []bool{true, true, false, false, false}},
+		{role: RoleViewer, want: []bool{true, false, false, false, false}},
+		{role: Role("guest"), want: []bool{false, false, false, false, false}},
+		{role: Role(""), want: []bool{false, false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		for i, p := range permissions {
+			t.Run(string(tt.role)+"/"+p.name, func(t *testing.T) {
+				if got := tt.role.Allows(p.p); got != tt.want[i] {
+					t.Errorf("%q.Allows(%s) = %v, want %v", tt.role, p.name, got, tt.want[i])
+				}
+			})
+		}
+	}
+
+	if RoleOwner.Allows(Permission(100)) {
+		t.Error("unknown permission is allowed")
+	}
+}
+
+func TestRoleCanAssign(t *testing.T) {
+	targets := []Role{RoleOwner, RoleAdmin, RoleEditor, RoleViewer}
+
+	// Разрешения перечислены в порядке targets
+	tests := []struct {
+		role Role
+		want []bool
+	}{
+		{role: RoleOwner, want: []bool{false, true, true, true}},
+		{role: RoleAdmin, want: []bool{false, false, true, true}},
+		{role: RoleEditor, want: []bool{false, false, false, false}},
+		{role: RoleViewer, want: []bool{false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		for i, target := range targets {
+			t.Run(string(tt.role)+"/"+string(target), func(t *testing.T) {
+				if got := tt.role.CanAssign(target); got != tt.want[i] {
+					t.Errorf("%s.CanAssign(%s) = %v, want %v", tt.role, target, got, tt.want[i])
+				}
+			})
+		}
+	}
+}
+
+func TestNewMember(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int64
+		role   Role
+		err    error
+	}{
+		{name: "valid", userID: 2, role: RoleEditor},
+		{name: "empty user", userID: 0, role: RoleEditor, err: ErrEmptyMember},
+		{name: "unknown role", userID: 2, role: Role("guest"), err: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMember(7, tt.userID, tt.role)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if m.BoardID != 7 || m.UserID != tt.userID || m.Role != tt.role || m.CreatedAt.IsZero() {
+				t.Errorf("member = %+v", m)
+			}
+		})
+	}
+}
+
+func TestMemberChangeRole(t *testing.T) {
+	tests := []struct {
+		name string
+		from Role
+		to   Role
+		want Role
+		err  error
+	}{
+		{name: "promote", from: RoleViewer, to: RoleAdmin, want: RoleAdmin},
+		{name: "demote", from: RoleAdmin, to: RoleViewer, want: RoleViewer},
+		{name: "unknown role", from: RoleEditor, to: Role("guest"), want: RoleEditor, err: ErrInvalidRole},
+		{name: "grant owner", from: RoleAdmin, to: RoleOwner, want: RoleAdmin, err: ErrOwnerRoleImmutable},
+		{name: "demote owner", from: RoleOwner, to: RoleAdmin, want: RoleOwner, err: ErrOwnerRoleImmutable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Member{BoardID: 7, UserID: 2, Role: tt.from}
+
+			err := m.ChangeRole(tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if m.Role != tt.want {
+				t.Errorf("role = %s, want %s", m.Role, tt.want)
+			}
+		})
+	}
+}
@@ -2,16 +2,138 @@ package board
 
 import (
 	"context"
+	"time"
 )
 
 type Repository interface {
 	Create(ctx context.Context, board *Board) error
 
+	// GetByID и GetByIDForUpdate не видят удаленные доски: для них это ErrBoardNotFound
 	GetByID(ctx context.Context, id int64) (*Board, error)
 
-	GetList(ctx context.Context) ([]*Board, error)
+	// GetByIDForUpdate читает доску и блокирует её до конца текущей транзакции
+	GetByIDForUpdate(ctx context.Context, id int64) (*Board, error)
+
+	// GetAnyByIDForUpdate — то же, что GetByIDForUpdate, но находит и удаленные доски
+	GetAnyByIDForUpdate(ctx context.Context, id int64) (*Board, error)
+
+	// List возвращает до query.Limit досок пользователя, начиная после query.After
+	List(ctx context.Context, query ListQuery) ([]*Board, error)
+
+	// ListTemplates возвращает шаблоны среди досок пользователя, отсортированные по названию
+	ListTemplates(ctx context.Context, userID int64) ([]*Board, error)
 
 	Update(ctx context.Context, board *Board) (*Board, error)
 
+	// PurgeDeleted окончательно удаляет до limit досок, удаленных не позже before,
+	// вместе с колонками и задачами, и возвращает их id
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) ([]int64, error)
+}
+
+type ColumnRepository interface {
+	Create(ctx context.Context, column *Column) error
+
+	GetByID(ctx context.Context, id int64) (*Column, error)
+
+	// ListByBoard возвращает колонки доски, отсортированные по позиции
+	ListByBoard(ctx context.Context, boardID int64) ([]*Column, error)
+
+	// CountByBoard возвращает количество колонок на доске
+	CountByBoard(ctx context.Context, boardID int64) (int, error)
+
+	Update(ctx context.Context, column *Column) (*Column, error)
+
+	// ShiftPositions сдвигает на delta позиции колонок доски в диапазоне [from, to]
+	ShiftPositions(ctx context.Context, boardID int64, from, to, delta int) error
+
+	Delete(ctx context.Context, id int64) error
+}
+
+type TaskRepository interface {
+	Create(ctx context.Context, task *Task) error
+
+	GetByID(ctx context.Context, id int64) (*Task, error)
+
+	// ListByBoard возвращает все задачи доски, отсортированные по позиции колонки и позиции в ней
+	ListByBoard(ctx context.Context, boardID int64) ([]*Task, error)
+
+	// CountByColumn возвращает количество задач в колонке
+	CountByColumn(ctx context.Context, columnID int64) (int, error)
+
+	Update(ctx context.Context, task *Task) (*Task, error)
+
+	// ShiftPositions сдвигает на delta позиции задач колонки в диапазоне [from, to]
+	ShiftPositions(ctx context.Context, columnID int64, from, to, delta int) error
+
 	Delete(ctx context.Context, id int64) error
 }
+
+type MemberRepository interface {
+	Create(ctx context.Context, member *Member) error
+
+	// Get возвращает участника доски или ErrMemberNotFound
+	Get(ctx context.Context, boardID, userID int64) (*Member, error)
+
+	// ListByBoard возвращает участников доски: сначала по старшинству роли, затем по времени добавления
+	ListByBoard(ctx context.Context, boardID int64) ([]*Member, error)
+
+	Update(ctx context.Context, member *Member) (*Member, error)
+
+	// Save создает участника или меняет роль уже существующего
+	Save(ctx context.Context, member *Member) error
+
+	Delete(ctx context.Context, boardID, userID int64) error
+}
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *Invitation) error
+
+	GetByID(ctx context.Context, id int64) (*Invitation, error)
+
+	// GetByTokenHash находит приглашение по хэшу токена без блокировки
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+
+	// GetByTokenHashForUpdate находит приглашение по хэшу токена и блокирует его до конца транзакции
+	GetByTokenHashForUpdate(ctx context.Context, tokenHash string) (*Invitation, error)
+
+	// ListPendingByBoard возвращает неотозванные, непринятые и непросроченные на момент now приглашения
+	ListPendingByBoard(ctx context.Context, boardID int64, now time.Time) ([]*Invitation, error)
+
+	// RevokePending отзывает все действующие приглашения на адрес, возвращает их количество
+	RevokePending(ctx context.Context, boardID int64, email string, now time.Time) (int64, error)
+
+	// Update сохраняет отметки о принятии и отзыве
+	Update(ctx context.Context, invitation *Invitation) error
+}
+
+type IdempotencyRepository interface {
+	// Reserve занимает ключ и возвращает true. Если ключ уже занят и не истек, возвращает false.
+	// Параллельный Reserve того же ключа ждет, пока транзакция первого не завершится.
+	Reserve(ctx context.Context, record *IdempotencyRecord) (bool, error)
+
+	Get(ctx context.Context, userID int64, operation, key string) (*IdempotencyRecord, error)
+
+	SaveResponse(ctx context.Context, userID int64, operation, key string, response []byte) error
+
+	// DeleteExpired удаляет истекшие ключи и возвращает их количество
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *OwnershipTransfer) error
+}
+
+// UserRepository — доступ к пользователям только на чтение, для проверки ссылок на них
+type UserRepository interface {
+	Exists(ctx context.Context, id int64) (bool, error)
+
+	// GetEmail возвращает адрес пользователя или ErrUserNotFound
+	GetEmail(ctx context.Context, id int64) (string, error)
+
+	// FindIDsByUsernames ищет пользователей по логину без учета регистра.
+	// Ключи результата — логины в нижнем регистре; ненайденных в нем нет
+	FindIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error)
+
+	// GetUsernames возвращает логины пользователей по id; ненайденных в результате нет
+	GetUsernames(ctx context.Context, ids []int64) (map[int64]string, error)
+}
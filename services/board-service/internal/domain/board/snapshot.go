@@ -0,0 +1,15 @@
+package board
+
+import (
+	"time"
+)
+
+// Snapshot — полный снимок доски для выгрузки: структура, участники
+// и логины всех упомянутых пользователей (участников и исполнителей)
+type Snapshot struct {
+	Structure *Structure
+	Members   []*Member
+	// id пользователя -> логин; по логину пользователей находят в другом окружении
+	Usernames  map[int64]string
+	ExportedAt time.Time
+}
@@ -0,0 +1,83 @@
+package board
+
+import (
+	"time"
+)
+
+// MaxTaskTitleLength — предел длины названия задачи в символах
+const MaxTaskTitleLength = 200
+
+// Task — задача в колонке. Position задает порядок внутри колонки,
+// AssigneeID == 0 означает, что исполнитель не назначен.
+type Task struct {
+	ID          int64
+	ColumnID    int64
+	Title       string
+	Description string
+	AssigneeID  int64
+	Position    int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewTask(columnID int64, title, description string, assigneeID int64, position int) (*Task, error) {
+	if err := validateTaskTitle(title); err != nil {
+		return nil, err
+	}
+
+	if position < 0 {
+		return nil, ErrInvalidPosition
+	}
+
+	return &Task{
+		ColumnID:    columnID,
+		Title:       title,
+		Description: description,
+		AssigneeID:  assigneeID,
+		Position:    position,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+func (t *Task) Rename(title string) error {
+	if err := validateTaskTitle(title); err != nil {
+		return err
+	}
+
+	t.Title = title
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (t *Task) Assign(assigneeID int64) {
+	t.AssigneeID = assigneeID
+	t.UpdatedAt = time.Now()
+}
+
+// MoveTo переносит задачу в колонку columnID на позицию position.
+// Сдвиг соседних задач — забота вызывающего кода.
+func (t *Task) MoveTo(columnID int64, position int) error {
+	if position < 0 {
+		return ErrInvalidPosition
+	}
+
+	t.ColumnID = columnID
+	t.Position = position
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func validateTaskTitle(title string) error {
+	if title == "" {
+		return ErrTaskTitleRequired
+	}
+
+	if len([]rune(title)) > MaxTaskTitleLength {
+		return ErrTaskTitleTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package board
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewTask(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		position int
+		err      error
+	}{
+		{name: "valid", title: "Write tests", position: 0},
+		{name: "title at the limit", title: strings.Repeat("я", MaxTaskTitleLength), position: 2},
+		{name: "empty title", title: "", position: 0, err: ErrTaskTitleRequired},
+		{name: "title too long", title: strings.Repeat("я", MaxTaskTitleLength+1), position: 0, err: ErrTaskTitleTooLong},
+		{name: "negative position", title: "Write tests", position: -1, err: ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, err := NewTask(3, tt.title, "details", 5, tt.position)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if task.ColumnID != 3 || task.Title != tt.title || task.Description != "details" || task.AssigneeID != 5 || task.Position != tt.position {
+				t.Errorf("task = %+v", task)
+			}
+		})
+	}
+}
+
+func TestTaskRename(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+		err   error
+	}{
+		{name: "valid", title: "New title", want: "New title"},
+		{name: "empty title", title: "", want: "Old", err: ErrTaskTitleRequired},
+		{name: "title too long", title: strings.Repeat("a", MaxTaskTitleLength+1), want: "Old", err: ErrTaskTitleTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := NewTask(1, "Old", "", 0, 0)
+			if err := task.Rename(tt.title); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if task.Title != tt.want {
+				t.Errorf("title = %q, want %q", task.Title, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskMoveTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		columnID   int64
+		position   int
+		wantColumn int64
+		wantPos    int
+		err        error
+	}{
+		{name: "same column", columnID: 1, position: 4, wantColumn: 1, wantPos: 4},
+		{name: "another column", columnID: 2, position: 0, wantColumn: 2, wantPos: 0},
+		{name: "negative position", columnID: 2, position: -1, wantColumn: 1, wantPos: 1, err: ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, _ := NewTask(1, "Task", "", 0, 1)
+			if err := task.MoveTo(tt.columnID, tt.position); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if task.ColumnID != tt.wantColumn || task.Position != tt.wantPos {
+				t.Errorf("task at column %d position %d, want %d/%d", task.ColumnID, task.Position, tt.wantColumn, tt.wantPos)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package board
+
+import (
+	"time"
+)
+
+// OwnershipTransfer — запись о передаче доски другому владельцу
+type OwnershipTransfer struct {
+	ID            int64
+	BoardID       int64
+	FromUserID    int64
+	ToUserID      int64
+	TransferredBy int64
+	TransferredAt time.Time
+}
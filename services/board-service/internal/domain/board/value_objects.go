@@ -1 +1,40 @@
 package board
+
+// Structure — доска целиком: колонки по порядку, в каждой задачи по порядку
+type Structure struct {
+	Board
+	Columns []ColumnStructure
+}
+
+type ColumnStructure struct {
+	Column
+	Tasks []*Task
+}
+
+// NewStructure раскладывает задачи по колонкам. Колонки и задачи должны прийти
+// уже отсортированными по позиции; задачи чужих колонок отбрасываются.
+func NewStructure(b *Board, columns []*Column, tasks []*Task) *Structure {
+	structure := &Structure{
+		Board:   *b,
+		Columns: make([]ColumnStructure, 0, len(columns)),
+	}
+
+	indexByID := make(map[int64]int, len(columns))
+	for i, c := range columns {
+		indexByID[c.ID] = i
+		structure.Columns = append(structure.Columns, ColumnStructure{
+			Column: *c,
+			Tasks:  make([]*Task, 0),
+		})
+	}
+
+	for _, t := range tasks {
+		i, ok := indexByID[t.ColumnID]
+		if !ok {
+			continue
+		}
+		structure.Columns[i].Tasks = append(structure.Columns[i].Tasks, t)
+	}
+
+	return structure
+}
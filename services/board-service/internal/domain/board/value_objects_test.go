@@ -0,0 +1,79 @@
+package board
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewStructure(t *testing.T) {
+	todo := &Column{ID: 1, Title: "Todo", Position: 0}
+	done := &Column{ID: 2, Title: "Done", Position: 1}
+
+	tests := []struct {
+		name    string
+		columns []*Column
+		tasks   []*Task
+		want    map[string][]string
+	}{
+		{
+			name:    "empty board",
+			columns: nil,
+			want:    map[string][]string{},
+		},
+		{
+			name:    "columns without tasks",
+			columns: []*Column{todo, done},
+			want:    map[string][]string{"Todo": {}, "Done": {}},
+		},
+		{
+			name:    "tasks keep their order",
+			columns: []*Column{todo, done},
+			tasks: []*Task{
+				{ID: 1, ColumnID: 1, Title: "a", Position: 0},
+				{ID: 2, ColumnID: 2, Title: "c", Position: 0},
+				{ID: 3, ColumnID: 1, Title: "b", Position: 1},
+			},
+			want: map[string][]string{"Todo": {"a", "b"}, "Done": {"c"}},
+		},
+		{
+			name:    "tasks of unknown columns are dropped",
+			columns: []*Column{todo},
+			tasks: []*Task{
+				{ID: 1, ColumnID: 1, Title: "a"},
+				{ID: 2, ColumnID: 3, Title: "lost"},
+			},
+			want: map[string][]string{"Todo": {"a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStructure(&Board{ID: 5, Title: "Board"}, tt.columns, tt.tasks)
+
+			if s.ID != 5 || s.Title != "Board" {
+				t.Errorf("board = %+v", s.Board)
+			}
+			if len(s.Columns) != len(tt.columns) {
+				t.Fatalf("columns = %d, want %d", len(s.Columns), len(tt.columns))
+			}
+
+			for i, c := range s.Columns {
+				if c.ID != tt.columns[i].ID {
+					t.Errorf("column %d = %d, want %d", i, c.ID, tt.columns[i].ID)
+				}
+				// Пустая колонка отдается как [], а не null
+				if c.Tasks == nil {
+					t.Errorf("column %s tasks are nil", c.Title)
+				}
+
+				titles := make([]string, 0, len(c.Tasks))
+				for _, task := range c.Tasks {
+					titles = append(titles, task.Title)
+				}
+				if !slices.Equal(titles, tt.want[c.Title]) {
+					t.Errorf("column %s = %v, want %v", c.Title, titles, tt.want[c.Title])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package identity
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUserID(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want int64
+		ok   bool
+	}{
+		{name: "authenticated", ctx: WithUserID(context.Background(), 7), want: 7, ok: true},
+		{name: "anonymous", ctx: context.Background()},
+		{name: "zero id", ctx: WithUserID(context.Background(), 0)},
+		{name: "negative id", ctx: WithUserID(context.Background(), -3), want: -3},
+		// Значение под чужим ключом с тем же именем не подменяет пользователя
+		{name: "plain string key", ctx: context.WithValue(context.Background(), "userIDKey", int64(7))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := UserID(tt.ctx)
+			if ok != tt.ok || (ok && got != tt.want) {
+				t.Fatalf("UserID = %d, %v, want %d, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.BoardCache = (*BoardCache)(nil)
+
+// BoardCache хранит структуру доски в Redis в виде JSON
+type BoardCache struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// entry — то, что реально лежит в Redis
+type entry struct {
+	Structure *board.Structure `json:"structure"`
+	StoredAt  time.Time        `json:"storedAt"`
+}
+
+// NewBoardCache принимает любой go-redis клиент, поэтому в тестах
+// его можно направить на miniredis. ttl — сколько запись хранится в Redis
+// целиком, включая время, когда она уже считается устаревшей.
+func NewBoardCache(client redis.UniversalClient, ttl time.Duration) *BoardCache {
+	return &BoardCache{client: client, ttl: ttl}
+}
+
+// Ключи одной доски делят hash tag {id}, поэтому в Redis Cluster они лежат
+// в одном слоте и скрипт Set и транзакция Invalidate работают с обоими сразу
+func structureKey(boardID int64) string {
+	return fmt.Sprintf("board:{%d}:structure", boardID)
+}
+
+// generationKey хранится без TTL: если бы счетчик истек и начался заново с нуля,
+// загрузка, начатая до этого, могла бы совпасть с ним и записать устаревшую структуру
+func generationKey(boardID int64) string {
+	return fmt.Sprintf("board:{%d}:generation", boardID)
+}
+
+// setIfGeneration записывает структуру, только если поколение не изменилось.
+// Проверка и запись идут одним скриптом, чтобы между ними не вклинился Invalidate.
+// KEYS[1] — структура, KEYS[2] — поколение; ARGV — ожидаемое поколение, JSON, TTL в мс
+var setIfGeneration = redis.NewScript(`
+local current = redis.call('GET', KEYS[2]) or '0'
+if current ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+func (c *BoardCache) Get(ctx context.Context, boardID int64) (*usecase.CachedStructure, error) {
+	data, err := c.client.Get(ctx, structureKey(boardID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get board from cache: %w", err)
+	}
+
+	var cached entry
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, fmt.Errorf("failed to decode cached board: %w", err)
+	}
+
+	return &usecase.CachedStructure{
+		Structure: cached.Structure,
+		StoredAt:  cached.StoredAt,
+	}, nil
+}
+
+func (c *BoardCache) Generation(ctx context.Context, boardID int64) (int64, error) {
+	generation, err := c.client.Get(ctx, generationKey(boardID)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get board cache generation: %w", err)
+	}
+
+	return generation, nil
+}
+
+func (c *BoardCache) Set(ctx context.Context, structure *board.Structure, generation int64) error {
+	data, err := json.Marshal(entry{Structure: structure, StoredAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to encode board for cache: %w", err)
+	}
+
+	keys := []string{structureKey(structure.ID), generationKey(structure.ID)}
+	if err := setIfGeneration.Run(ctx, c.client, keys, generation, data, c.ttl.Milliseconds()).Err(); err != nil {
+		return fmt.Errorf("failed to put board into cache: %w", err)
+	}
+
+	return nil
+}
+
+// Invalidate удаляет запись и сменяет поколение, чтобы уже идущие загрузки
+// не вернули в кэш прочитанную до изменения структуру
+func (c *BoardCache) Invalidate(ctx context.Context, boardID int64) error {
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, generationKey(boardID))
+		pipe.Del(ctx, structureKey(boardID))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invalidate board cache: %w", err)
+	}
+
+	return nil
+}
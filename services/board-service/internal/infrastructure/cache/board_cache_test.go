@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func newTestCache(t *testing.T, ttl time.Duration) (*BoardCache, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewBoardCache(client, ttl), mr
+}
+
+func testStructure(boardID int64) *board.Structure {
+	b := &board.Board{ID: boardID, Title: "Board", Owner: 1, Version: 3}
+	columns := []*board.Column{{ID: 10, BoardID: boardID, Title: "Todo", Position: 0}}
+	tasks := []*board.Task{{ID: 100, ColumnID: 10, Title: "Task", Position: 0}}
+
+	return board.NewStructure(b, columns, tasks)
+}
+
+func TestBoardCacheGetMiss(t *testing.T) {
+	c, _ := newTestCache(t, time.Minute)
+
+	cached, err := c.Get(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if cached != nil {
+		t.Fatalf("Get on empty cache = %+v, want nil", cached)
+	}
+}
+
+func TestBoardCacheSetGet(t *testing.T) {
+	c, _ := newTestCache(t, time.Minute)
+	ctx := context.Background()
+
+	before := time.Now()
+	if err := c.Set(ctx, testStructure(1), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	cached, err := c.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if cached == nil {
+		t.Fatal("Get after Set returned nil")
+	}
+
+	s := cached.Structure
+	if s.ID != 1 || s.Title != "Board" || s.Version != 3 {
+		t.Errorf("board = %+v, want id 1, title Board, version 3", s.Board)
+	}
+	if len(s.Columns) != 1 || len(s.Columns[0].Tasks) != 1 || s.Columns[0].Tasks[0].ID != 100 {
+		t.Errorf("columns = %+v, want one column with task 100", s.Columns)
+	}
+	if cached.StoredAt.Before(before.Truncate(time.Second)) {
+		t.Errorf("StoredAt = %v, want not before %v", cached.StoredAt, before)
+	}
+}
+
+func TestBoardCacheInvalidate(t *testing.T) {
+	c, _ := newTestCache(t, time.Minute)
+	ctx := context.Background()
+
+	if err := c.Set(ctx, testStructure(1), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := c.Set(ctx, testStructure(2), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := c.Invalidate(ctx, 1); err != nil {
+		t.Fatalf("Invalidate: %v", err)
+	}
+
+	if cached, err := c.Get(ctx, 1); err != nil || cached != nil {
+		t.Fatalf("Get invalidated board = %+v, %v; want nil, nil", cached, err)
+	}
+
+	// Сброс одной доски не трогает другие
+	if cached, err := c.Get(ctx, 2); err != nil || cached == nil {
+		t.Fatalf("Get other board = %+v, %v; want cached structure", cached, err)
+	}
+
+	// Сброс отсутствующей записи — не ошибка
+	if err := c.Invalidate(ctx, 3); err != nil {
+		t.Fatalf("Invalidate missing board: %v", err)
+	}
+}
+
+func TestBoardCacheTTL(t *testing.T) {
+	c, mr := newTestCache(t, time.Minute)
+	ctx := context.Background()
+
+	if err := c.Set(ctx, testStructure(1), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if ttl := mr.TTL(structureKey(1)); ttl != time.Minute {
+		t.Fatalf("key TTL = %v, want %v", ttl, time.Minute)
+	}
+
+	mr.FastForward(59 * time.Second)
+	if cached, err := c.Get(ctx, 1); err != nil || cached == nil {
+		t.Fatalf("Get before expiry = %+v, %v; want cached structure", cached, err)
+	}
+
+	mr.FastForward(time.Second)
+	if cached, err := c.Get(ctx, 1); err != nil || cached != nil {
+		t.Fatalf("Get after expiry = %+v, %v; want nil, nil", cached, err)
+	}
+}
+
+func TestBoardCacheBrokenEntry(t *testing.T) {
+	c, mr := newTestCache(t, time.Minute)
+
+	if err := mr.Set(structureKey(1), "{not json"); err != nil {
+		t.Fatalf("miniredis Set: %v", err)
+	}
+
+	if _, err := c.Get(context.Background(), 1); err == nil {
+		t.Fatal("Get of broken entry returned no error")
+	}
+}
+
+func TestBoardCacheUnavailable(t *testing.T) {
+	c, mr := newTestCache(t, time.Minute)
+	mr.Close()
+
+	if _, err := c.Get(context.Background(), 1); err == nil {
+		t.Fatal("Get with Redis down returned no error")
+	}
+	if err := c.Invalidate(context.Background(), 1); err == nil {
+		t.Fatal("Invalidate with Redis down returned no error")
+	}
+}
+
+func TestBoardCacheGeneration(t *testing.T) {
+	c, _ := newTestCache(t, time.Minute)
+	ctx := context.Background()
+
+	generation, err := c.Generation(ctx, 1)
+	if err != nil {
+		t.Fatalf("Generation: %v", err)
+	}
+	if generation != 0 {
+		t.Fatalf("initial generation = %d, want 0", generation)
+	}
+
+	for want := int64(1); want <= 2; want++ {
+		if err := c.Invalidate(ctx, 1); err != nil {
+			t.Fatalf("Invalidate: %v", err)
+		}
+
+		generation, err := c.Generation(ctx, 1)
+		if err != nil {
+			t.Fatalf("Generation: %v", err)
+		}
+		if generation != want {
+			t.Fatalf("generation after %d invalidations = %d", want, generation)
+		}
+	}
+
+	// Поколение у каждой доски свое
+	if generation, _ := c.Generation(ctx, 2); generation != 0 {
+		t.Fatalf("generation of untouched board = %d, want 0", generation)
+	}
+}
+
+func TestBoardCacheSetRejectsStaleGeneration(t *testing.T) {
+	c, mr := newTestCache(t, time.Minute)
+	ctx := context.Background()
+
+	// Загрузка прочитала поколение, потом доску изменили и сбросили кэш
+	generation, err := c.Generation(ctx, 1)
+	if err != nil {
+		t.Fatalf("Generation: %v", err)
+	}
+	if err := c.Invalidate(ctx, 1); err != nil {
+		t.Fatalf("Invalidate: %v", err)
+	}
+
+	if err := c.Set(ctx, testStructure(1), generation); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if cached, err := c.Get(ctx, 1); err != nil || cached != nil {
+		t.Fatalf("Get after stale Set = %+v, %v; want nil, nil", cached, err)
+	}
+
+	// Загрузка, начатая после сброса, кэшируется как обычно
+	if err := c.Set(ctx, testStructure(1), generation+1); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if cached, err := c.Get(ctx, 1); err != nil || cached == nil {
+		t.Fatalf("Get after current Set = %+v, %v; want cached structure", cached, err)
+	}
+
+	// Счетчик не должен истекать вместе с записью
+	if ttl := mr.TTL(generationKey(1)); ttl != 0 {
+		t.Fatalf("generation key TTL = %v, want none", ttl)
+	}
+}
@@ -0,0 +1,66 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.ExportWriter = (*CSVWriter)(nil)
+
+var csvHeader = []string{
+	"column_id", "column_title", "column_position",
+	"task_id", "task_title", "task_description", "task_position",
+	"assignee_id", "assignee_username", "created_at", "updated_at",
+}
+
+// CSVWriter выгружает задачи доски плоской таблицей: строка на задачу
+type CSVWriter struct{}
+
+func NewCSVWriter() *CSVWriter {
+	return &CSVWriter{}
+}
+
+func (w *CSVWriter) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+func (w *CSVWriter) FileExtension() string {
+	return "csv"
+}
+
+func (w *CSVWriter) Write(out io.Writer, snapshot *board.Snapshot) error {
+	writer := csv.NewWriter(out)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, c := range snapshot.Structure.Columns {
+		for _, t := range c.Tasks {
+			assigneeID := ""
+			if t.AssigneeID != 0 {
+				assigneeID = strconv.FormatInt(t.AssigneeID, 10)
+			}
+
+			record := []string{
+				strconv.FormatInt(c.ID, 10), c.Title, strconv.Itoa(c.Position),
+				strconv.FormatInt(t.ID, 10), t.Title, t.Description, strconv.Itoa(t.Position),
+				assigneeID, snapshot.Usernames[t.AssigneeID],
+				t.CreatedAt.UTC().Format(time.RFC3339), t.UpdatedAt.UTC().Format(time.RFC3339),
+			}
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,61 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestCSVWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot func() *board.Snapshot
+		rows     [][]string
+	}{
+		{
+			name:     "task per row",
+			snapshot: testSnapshot,
+			rows: [][]string{
+				// Время выгружается в UTC, а описание с переводом строки остается одной ячейкой
+				{"5", "To do", "0", "7", "Write, \"docs\"", "first line\nsecond line", "0", "20", "bob", "2024-05-01T06:00:00Z", "2024-05-01T06:00:00Z"},
+				{"5", "To do", "0", "8", "Ship\nit", "", "1", "", "", "2024-05-01T06:00:00Z", "2024-05-01T06:00:00Z"},
+			},
+		},
+		{
+			name: "board without tasks",
+			snapshot: func() *board.Snapshot {
+				s := testSnapshot()
+				s.Structure.Columns = s.Structure.Columns[1:]
+				return s
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := NewCSVWriter().Write(&out, tt.snapshot()); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+
+			records, err := csv.NewReader(&out).ReadAll()
+			if err != nil {
+				t.Fatalf("read csv: %v", err)
+			}
+			if len(records) == 0 || !slices.Equal(records[0], csvHeader) {
+				t.Fatalf("header = %v, want %v", records, csvHeader)
+			}
+			if got := records[1:]; len(got) != len(tt.rows) {
+				t.Fatalf("rows = %q, want %q", got, tt.rows)
+			}
+			for i, row := range tt.rows {
+				if !slices.Equal(records[i+1], row) {
+					t.Errorf("row %d = %q, want %q", i, records[i+1], row)
+				}
+			}
+		})
+	}
+}
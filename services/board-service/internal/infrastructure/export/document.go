@@ -0,0 +1,138 @@
+package export
+
+import (
+	"sort"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+const (
+	// DocumentFormat отличает выгрузку доски Taskify от произвольного JSON
+	DocumentFormat = "taskify.board"
+	// DocumentVersion растет при несовместимых изменениях схемы документа.
+	// Совместимые добавления полей версию не меняют
+	DocumentVersion = 1
+)
+
+// Document — самодостаточная выгрузка доски без потерь. Пользователи перечислены
+// вместе с логинами: id имеют смысл только в исходном окружении
+type Document struct {
+	Format     string           `json:"format"`
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exportedAt"`
+	Board      DocumentBoard    `json:"board"`
+	Columns    []DocumentColumn `json:"columns"`
+	Members    []DocumentMember `json:"members"`
+	Users      []DocumentUser   `json:"users"`
+}
+
+type DocumentBoard struct {
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	OwnerID     int64      `json:"ownerId"`
+	Version     int64      `json:"version"`
+	IsTemplate  bool       `json:"isTemplate"`
+	ArchivedAt  *time.Time `json:"archivedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type DocumentColumn struct {
+	ID        int64          `json:"id"`
+	Title     string         `json:"title"`
+	Position  int            `json:"position"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+	Tasks     []DocumentTask `json:"tasks"`
+}
+
+type DocumentTask struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// 0 — исполнитель не назначен
+	AssigneeID int64     `json:"assigneeId"`
+	Position   int       `json:"position"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type DocumentMember struct {
+	UserID    int64     `json:"userId"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DocumentUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// NewDocument раскладывает снимок доски в документ текущей версии
+func NewDocument(s *board.Snapshot) *Document {
+	b := s.Structure.Board
+
+	doc := &Document{
+		Format:     DocumentFormat,
+		Version:    DocumentVersion,
+		ExportedAt: s.ExportedAt,
+		Board: DocumentBoard{
+			ID:          b.ID,
+			Title:       b.Title,
+			Description: b.Description,
+			OwnerID:     b.Owner,
+			Version:     b.Version,
+			IsTemplate:  b.IsTemplate,
+			ArchivedAt:  b.ArchivedAt,
+			CreatedAt:   b.CreatedAt,
+			UpdatedAt:   b.UpdatedAt,
+		},
+		Columns: make([]DocumentColumn, 0, len(s.Structure.Columns)),
+		Members: make([]DocumentMember, 0, len(s.Members)),
+		Users:   make([]DocumentUser, 0, len(s.Usernames)),
+	}
+
+	for _, c := range s.Structure.Columns {
+		column := DocumentColumn{
+			ID:        c.ID,
+			Title:     c.Title,
+			Position:  c.Position,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+			Tasks:     make([]DocumentTask, 0, len(c.Tasks)),
+		}
+
+		for _, t := range c.Tasks {
+			column.Tasks = append(column.Tasks, DocumentTask{
+				ID:          t.ID,
+				Title:       t.Title,
+				Description: t.Description,
+				AssigneeID:  t.AssigneeID,
+				Position:    t.Position,
+				CreatedAt:   t.CreatedAt,
+				UpdatedAt:   t.UpdatedAt,
+			})
+		}
+
+		doc.Columns = append(doc.Columns, column)
+	}
+
+	for _, m := range s.Members {
+		doc.Members = append(doc.Members, DocumentMember{
+			UserID:    m.UserID,
+			Role:      string(m.Role),
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	for id, username := range s.Usernames {
+		doc.Users = append(doc.Users, DocumentUser{ID: id, Username: username})
+	}
+
+	// Порядок map случаен, а выгрузка одной и той же доски должна совпадать байт в байт
+	sort.Slice(doc.Users, func(i, j int) bool { return doc.Users[i].ID < doc.Users[j].ID })
+
+	return doc
+}
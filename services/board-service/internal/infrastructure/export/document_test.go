@@ -0,0 +1,72 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+var (
+	testCreated  = time.Date(2024, 5, 1, 9, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	testExported = time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+)
+
+// testSnapshot — доска с двумя колонками: в первой две задачи, вторая пустая
+func testSnapshot() *board.Snapshot {
+	return &board.Snapshot{
+		Structure: &board.Structure{
+			Board: board.Board{ID: 1, Title: "Release", Description: "Q2 plan", Owner: 10, Version: 3, CreatedAt: testCreated, UpdatedAt: testCreated},
+			Columns: []board.ColumnStructure{
+				{
+					Column: board.Column{ID: 5, BoardID: 1, Title: "To do", Position: 0, CreatedAt: testCreated, UpdatedAt: testCreated},
+					Tasks: []*board.Task{
+						{ID: 7, ColumnID: 5, Title: "Write, \"docs\"", Description: "first line\nsecond line", AssigneeID: 20, Position: 0, CreatedAt: testCreated, UpdatedAt: testCreated},
+						{ID: 8, ColumnID: 5, Title: "Ship\nit", Position: 1, CreatedAt: testCreated, UpdatedAt: testCreated},
+					},
+				},
+				{Column: board.Column{ID: 6, BoardID: 1, Title: "Done", Position: 1, CreatedAt: testCreated, UpdatedAt: testCreated}},
+			},
+		},
+		Members: []*board.Member{
+			{BoardID: 1, UserID: 10, Role: board.RoleOwner, CreatedAt: testCreated},
+			{BoardID: 1, UserID: 20, Role: board.RoleEditor, CreatedAt: testCreated},
+		},
+		Usernames:  map[int64]string{20: "bob", 10: "alice", 15: "carol"},
+		ExportedAt: testExported,
+	}
+}
+
+func TestNewDocument(t *testing.T) {
+	doc := NewDocument(testSnapshot())
+
+	if doc.Format != DocumentFormat || doc.Version != DocumentVersion || !doc.ExportedAt.Equal(testExported) {
+		t.Errorf("header = %q v%d at %v", doc.Format, doc.Version, doc.ExportedAt)
+	}
+	if doc.Board.ID != 1 || doc.Board.OwnerID != 10 || doc.Board.Version != 3 || doc.Board.Title != "Release" {
+		t.Errorf("board = %+v", doc.Board)
+	}
+
+	if len(doc.Columns) != 2 || len(doc.Columns[0].Tasks) != 2 || doc.Columns[1].Tasks == nil {
+		t.Fatalf("columns = %+v", doc.Columns)
+	}
+	if task := doc.Columns[0].Tasks[0]; task.ID != 7 || task.AssigneeID != 20 || task.Description != "first line\nsecond line" {
+		t.Errorf("task = %+v", task)
+	}
+
+	if len(doc.Members) != 2 || doc.Members[1].UserID != 20 || doc.Members[1].Role != "editor" {
+		t.Errorf("members = %+v", doc.Members)
+	}
+
+	// Пользователи отсортированы по id, чтобы выгрузка была стабильной
+	want := []DocumentUser{{ID: 10, Username: "alice"}, {ID: 15, Username: "carol"}, {ID: 20, Username: "bob"}}
+	if len(doc.Users) != len(want) {
+		t.Fatalf("users = %+v, want %+v", doc.Users, want)
+	}
+	for i := range want {
+		if doc.Users[i] != want[i] {
+			t.Errorf("users = %+v, want %+v", doc.Users, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.BoardDocumentReader = (*JSONReader)(nil)
+
+// JSONReader читает обратно документ, выгруженный JSONWriter
+type JSONReader struct{}
+
+func NewJSONReader() *JSONReader {
+	return &JSONReader{}
+}
+
+// documentHeader — поля, по которым документ узнают до разбора остальной схемы
+type documentHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+func (r *JSONReader) Read(data []byte) (*board.Snapshot, error) {
+	var header documentHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("%w: %v", board.ErrInvalidImport, err)
+	}
+
+	if header.Format != DocumentFormat {
+		return nil, fmt.Errorf("%w: not a %s document", board.ErrInvalidImport, DocumentFormat)
+	}
+
+	// Версия растет только при несовместимых изменениях, поэтому документ новее
+	// текущей версии прочитать без потерь нельзя
+	switch {
+	case header.Version < 1:
+		return nil, fmt.Errorf("%w: document version is missing", board.ErrInvalidImport)
+	case header.Version > DocumentVersion:
+		return nil, fmt.Errorf("%w: document version %d is newer than supported version %d, upgrade Taskify to import it",
+			board.ErrUnsupportedDocumentVersion, header.Version, DocumentVersion)
+	}
+
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", board.ErrInvalidImport, err)
+	}
+
+	return doc.toSnapshot(), nil
+}
+
+// toSnapshot собирает снимок с id исходного окружения. Колонки и задачи
+// упорядочены по позиции, как того требует Structure
+func (d *Document) toSnapshot() *board.Snapshot {
+	b := board.Board{
+		ID:          d.Board.ID,
+		Title:       d.Board.Title,
+		Description: d.Board.Description,
+		Owner:       d.Board.OwnerID,
+		Version:     d.Board.Version,
+		IsTemplate:  d.Board.IsTemplate,
+		ArchivedAt:  d.Board.ArchivedAt,
+		CreatedAt:   d.Board.CreatedAt,
+		UpdatedAt:   d.Board.UpdatedAt,
+	}
+
+	columns := append([]DocumentColumn(nil), d.Columns...)
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].Position < columns[j].Position })
+
+	structure := &board.Structure{
+		Board:   b,
+		Columns: make([]board.ColumnStructure, 0, len(columns)),
+	}
+
+	for _, c := range columns {
+		tasks := append([]DocumentTask(nil), c.Tasks...)
+		sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].Position < tasks[j].Position })
+
+		column := board.ColumnStructure{
+			Column: board.Column{
+				ID:        c.ID,
+				BoardID:   b.ID,
+				Title:     c.Title,
+				Position:  c.Position,
+				CreatedAt: c.CreatedAt,
+				UpdatedAt: c.UpdatedAt,
+			},
+			Tasks: make([]*board.Task, 0, len(tasks)),
+		}
+
+		for _, t := range tasks {
+			column.Tasks = append(column.Tasks, &board.Task{
+				ID:          t.ID,
+				ColumnID:    c.ID,
+				Title:       t.Title,
+				Description: t.Description,
+				AssigneeID:  t.AssigneeID,
+				Position:    t.Position,
+				CreatedAt:   t.CreatedAt,
+				UpdatedAt:   t.UpdatedAt,
+			})
+		}
+
+		structure.Columns = append(structure.Columns, column)
+	}
+
+	members := make([]*board.Member, 0, len(d.Members))
+	for _, m := range d.Members {
+		members = append(members, &board.Member{
+			BoardID:   b.ID,
+			UserID:    m.UserID,
+			Role:      board.Role(m.Role),
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	usernames := make(map[int64]string, len(d.Users))
+	for _, u := range d.Users {
+		usernames[u.ID] = u.Username
+	}
+
+	return &board.Snapshot{
+		Structure:  structure,
+		Members:    members,
+		Usernames:  usernames,
+		ExportedAt: d.ExportedAt,
+	}
+}
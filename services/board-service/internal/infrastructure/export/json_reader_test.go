@@ -0,0 +1,109 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestJSONReaderHeader(t *testing.T) {
+	document := func(format string, version int) string {
+		return `{"format":"` + format + `","version":` + strconv.Itoa(version) + `,"board":{"title":"Board"}}`
+	}
+
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{name: "current version", data: document(DocumentFormat, DocumentVersion)},
+		{name: "newer version", data: document(DocumentFormat, DocumentVersion+1), err: board.ErrUnsupportedDocumentVersion},
+		{name: "zero version", data: document(DocumentFormat, 0), err: board.ErrInvalidImport},
+		{name: "negative version", data: document(DocumentFormat, -1), err: board.ErrInvalidImport},
+		{name: "no version", data: `{"format":"taskify.board"}`, err: board.ErrInvalidImport},
+		{name: "other format", data: document("trello", DocumentVersion), err: board.ErrInvalidImport},
+		{name: "no format", data: `{"version":1}`, err: board.ErrInvalidImport},
+		{name: "not json", data: `# Board`, err: board.ErrInvalidImport},
+		// Заголовок верный, но тело не совпадает со схемой
+		{name: "broken body", data: `{"format":"taskify.board","version":1,"columns":{}}`, err: board.ErrInvalidImport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot, err := NewJSONReader().Read([]byte(tt.data))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && snapshot.Structure.Title != "Board" {
+				t.Errorf("title = %q, want Board", snapshot.Structure.Title)
+			}
+		})
+	}
+}
+
+// Документ JSONWriter читается обратно без потерь
+func TestJSONReaderRoundTrip(t *testing.T) {
+	var out bytes.Buffer
+	if err := NewJSONWriter().Write(&out, testSnapshot()); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	got, err := NewJSONReader().Read(out.Bytes())
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	want := testSnapshot()
+	if got.Structure.Board.ID != 1 || got.Structure.Title != "Release" || got.Structure.Owner != 10 || !got.Structure.CreatedAt.Equal(testCreated) {
+		t.Errorf("board = %+v", got.Structure.Board)
+	}
+	if len(got.Structure.Columns) != 2 {
+		t.Fatalf("columns = %+v", got.Structure.Columns)
+	}
+	for i, c := range got.Structure.Columns {
+		wc := want.Structure.Columns[i]
+		if c.ID != wc.ID || c.BoardID != 1 || c.Title != wc.Title || len(c.Tasks) != len(wc.Tasks) {
+			t.Errorf("column %d = %+v, want %+v", i, c.Column, wc.Column)
+			continue
+		}
+		for j, task := range c.Tasks {
+			wt := wc.Tasks[j]
+			if task.ID != wt.ID || task.ColumnID != wc.ID || task.Title != wt.Title || task.Description != wt.Description || task.AssigneeID != wt.AssigneeID {
+				t.Errorf("task %d/%d = %+v, want %+v", i, j, task, wt)
+			}
+		}
+	}
+	if len(got.Members) != 2 || got.Members[1].UserID != 20 || got.Members[1].Role != board.RoleEditor {
+		t.Errorf("members = %+v", got.Members)
+	}
+	if len(got.Usernames) != 3 || got.Usernames[20] != "bob" || !got.ExportedAt.Equal(testExported) {
+		t.Errorf("usernames = %v, exported at %v", got.Usernames, got.ExportedAt)
+	}
+}
+
+// Колонки и задачи упорядочиваются по позиции, а не по порядку в файле
+func TestJSONReaderOrdersByPosition(t *testing.T) {
+	data := `{"format":"taskify.board","version":1,"board":{"title":"Board"},"columns":[
+		{"id":2,"title":"Done","position":1,"tasks":[]},
+		{"id":1,"title":"Todo","position":0,"tasks":[
+			{"id":12,"title":"second","position":1},
+			{"id":11,"title":"first","position":0}
+		]}
+	]}`
+
+	snapshot, err := NewJSONReader().Read([]byte(data))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	columns := snapshot.Structure.Columns
+	if len(columns) != 2 || columns[0].Title != "Todo" || columns[1].Title != "Done" {
+		t.Fatalf("columns = %+v", columns)
+	}
+	if tasks := columns[0].Tasks; len(tasks) != 2 || tasks[0].Title != "first" || tasks[1].Title != "second" {
+		t.Errorf("tasks = %+v", tasks)
+	}
+}
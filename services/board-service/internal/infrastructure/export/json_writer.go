@@ -0,0 +1,33 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.ExportWriter = (*JSONWriter)(nil)
+
+// JSONWriter выгружает доску версионированным документом Document
+type JSONWriter struct{}
+
+func NewJSONWriter() *JSONWriter {
+	return &JSONWriter{}
+}
+
+func (w *JSONWriter) ContentType() string {
+	return "application/json"
+}
+
+func (w *JSONWriter) FileExtension() string {
+	return "json"
+}
+
+func (w *JSONWriter) Write(out io.Writer, snapshot *board.Snapshot) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(NewDocument(snapshot))
+}
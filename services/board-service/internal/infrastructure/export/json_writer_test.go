@@ -0,0 +1,33 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONWriter(t *testing.T) {
+	write := func() []byte {
+		var out bytes.Buffer
+		if err := NewJSONWriter().Write(&out, testSnapshot()); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		return out.Bytes()
+	}
+
+	first := write()
+	var doc Document
+	if err := json.Unmarshal(first, &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if doc.Format != DocumentFormat || doc.Version != DocumentVersion || len(doc.Columns) != 2 || len(doc.Users) != 3 {
+		t.Errorf("document = %+v", doc)
+	}
+
+	// Одна и та же доска выгружается байт в байт одинаково
+	for range 5 {
+		if !bytes.Equal(write(), first) {
+			t.Fatal("repeated export differs")
+		}
+	}
+}
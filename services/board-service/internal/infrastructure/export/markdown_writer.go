@@ -0,0 +1,68 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.ExportWriter = (*MarkdownWriter)(nil)
+
+// MarkdownWriter выгружает доску чек-листом: заголовок на колонку, пункт на задачу
+type MarkdownWriter struct{}
+
+func NewMarkdownWriter() *MarkdownWriter {
+	return &MarkdownWriter{}
+}
+
+func (w *MarkdownWriter) ContentType() string {
+	return "text/markdown; charset=utf-8"
+}
+
+func (w *MarkdownWriter) FileExtension() string {
+	return "md"
+}
+
+func (w *MarkdownWriter) Write(out io.Writer, snapshot *board.Snapshot) error {
+	buf := bufio.NewWriter(out)
+
+	fmt.Fprintf(buf, "# %s\n", markdownLine(snapshot.Structure.Title))
+	if snapshot.Structure.Description != "" {
+		fmt.Fprintf(buf, "\n%s\n", snapshot.Structure.Description)
+	}
+
+	for _, c := range snapshot.Structure.Columns {
+		fmt.Fprintf(buf, "\n## %s\n\n", markdownLine(c.Title))
+
+		if len(c.Tasks) == 0 {
+			buf.WriteString("_No tasks_\n")
+			continue
+		}
+
+		for _, t := range c.Tasks {
+			fmt.Fprintf(buf, "- [ ] %s", markdownLine(t.Title))
+			if username, ok := snapshot.Usernames[t.AssigneeID]; ok && t.AssigneeID != 0 {
+				fmt.Fprintf(buf, " (@%s)", username)
+			}
+			buf.WriteString("\n")
+
+			// Описание — вложенный абзац под пунктом
+			if t.Description != "" {
+				for _, line := range strings.Split(t.Description, "\n") {
+					fmt.Fprintf(buf, "  %s\n", line)
+				}
+			}
+		}
+	}
+
+	return buf.Flush()
+}
+
+// markdownLine склеивает многострочный текст в одну строку заголовка или пункта
+func markdownLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,71 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestMarkdownWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot func() *board.Snapshot
+		want     string
+	}{
+		{
+			name:     "board",
+			snapshot: testSnapshot,
+			want: "# Release\n\nQ2 plan\n" +
+				"\n## To do\n\n" +
+				"- [ ] Write, \"docs\" (@bob)\n  first line\n  second line\n" +
+				"- [ ] Ship it\n" +
+				"\n## Done\n\n_No tasks_\n",
+		},
+		{
+			// Исполнитель без логина выгружается без упоминания
+			name: "no description and unknown assignee",
+			snapshot: func() *board.Snapshot {
+				s := testSnapshot()
+				s.Structure.Description = ""
+				s.Structure.Columns = s.Structure.Columns[:1]
+				s.Usernames = nil
+				return s
+			},
+			want: "# Release\n" +
+				"\n## To do\n\n" +
+				"- [ ] Write, \"docs\"\n  first line\n  second line\n" +
+				"- [ ] Ship it\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := NewMarkdownWriter().Write(&out, tt.snapshot()); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("markdown =\n%s\nwant\n%s", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Title", want: "Title"},
+		{in: "two\nlines", want: "two lines"},
+		{in: "  spaced \t out  ", want: "spaced out"},
+		{in: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := markdownLine(tt.in); got != tt.want {
+			t.Errorf("markdownLine(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
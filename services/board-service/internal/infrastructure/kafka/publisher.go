@@ -0,0 +1,51 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"Taskify/services/board-service/internal/infrastructure/outbox"
+)
+
+var _ outbox.Publisher = (*Publisher)(nil)
+
+const batchTimeout = 5 * time.Millisecond
+
+type Publisher struct {
+	writer *kafka.Writer
+}
+
+func NewPublisher(brokers []string, topic string) *Publisher {
+	return &Publisher{
+		writer: &kafka.Writer{
+			Addr:  kafka.TCP(brokers...),
+			Topic: topic,
+			// Партиция выбирается по ключу — id доски, это и дает порядок внутри доски
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			// Релей пишет по одному сообщению и ждет подтверждения, держа строки outbox
+			// заблокированными. С дефолтной секундой ожидания пачки это одно событие в секунду
+			BatchTimeout: batchTimeout,
+		},
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg outbox.Message) error {
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Value,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write message to kafka: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Publisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+var _ usecase.InvitationMailer = (*LogMailer)(nil)
+
+// LogMailer имитирует отправку приглашения записью в лог.
+// Токен попадает в лог целиком — это почтовый ящик для локальной разработки.
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) SendInvitation(_ context.Context, inv *board.Invitation, token string) error {
+	log.Info().
+		Int64("board_id", inv.BoardID).
+		Int64("invitation_id", inv.ID).
+		Str("role", string(inv.Role)).
+		Time("expires_at", inv.ExpiresAt).
+		Msgf("invitation email sent to %s: token %s", inv.Email, token)
+
+	return nil
+}
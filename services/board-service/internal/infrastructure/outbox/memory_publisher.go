@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+var _ Publisher = (*MemoryPublisher)(nil)
+
+// MemoryPublisher — Publisher в памяти для тестов: запоминает опубликованные
+// сообщения по порядку и может отказывать в публикации
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+	fail     func(msg Message) error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// FailWith задает, какие сообщения отклонять: ненулевая ошибка fn отменяет публикацию.
+// nil снова принимает всё
+func (p *MemoryPublisher) FailWith(fn func(msg Message) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fail = fn
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if p.fail != nil {
+		if err := p.fail(msg); err != nil {
+			return err
+		}
+	}
+
+	p.messages = append(p.messages, msg)
+
+	return nil
+}
+
+// Messages возвращает копию опубликованных сообщений в порядке публикации
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Message(nil), p.messages...)
+}
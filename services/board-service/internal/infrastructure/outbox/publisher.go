@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Message — то, что релей отдает брокеру.
+// Key — id доски: все события одной доски попадают в одну партицию и сохраняют порядок.
+type Message struct {
+	Key   string
+	Value []byte
+}
+
+// Publisher — брокер сообщений. Настоящая реализация — kafka.Publisher,
+// в тестах подставляется MemoryPublisher.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Envelope — формат события в топике
+type Envelope struct {
+	EventID    string          `json:"eventId"`
+	EventType  string          `json:"eventType"`
+	BoardID    int64           `json:"boardId"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Payload    json.RawMessage `json:"payload"`
+}
@@ -0,0 +1,167 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Record — строка outbox_events, ожидающая отправки
+type Record struct {
+	ID        int64
+	EventID   string
+	BoardID   int64
+	EventType string
+	Payload   []byte
+	CreatedAt time.Time
+	Attempts  int
+}
+
+// Store — доступ релея к таблице outbox_events. Все методы работают
+// в транзакции из контекста, открытой через TxManager.
+type Store interface {
+	// FetchPending блокирует до limit готовых к отправке событий (FOR UPDATE SKIP LOCKED)
+	FetchPending(ctx context.Context, limit int) ([]Record, error)
+	// HasEarlierPending сообщает, есть ли у доски более раннее неотправленное событие
+	HasEarlierPending(ctx context.Context, boardID, id int64) (bool, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, reason string) error
+}
+
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// Задержка перед повтором растет как MinBackoff * 2^attempts, но не больше MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Relay — фоновый процесс, который вычитывает outbox_events и публикует их в брокер.
+//
+// Гарантии: at-least-once (строка помечается отправленной в той же транзакции,
+// что и блокировка, и при падении между Publish и Commit событие уйдет повторно)
+// и порядок внутри доски: событие не публикуется, пока у его доски есть более раннее
+// неотправленное, даже если оно заблокировано другим экземпляром релея или ждет повтора.
+type Relay struct {
+	tx        TxManager
+	store     Store
+	publisher Publisher
+	cfg       Config
+}
+
+func NewRelay(tx TxManager, store Store, publisher Publisher, cfg Config) *Relay {
+	return &Relay{tx: tx, store: store, publisher: publisher, cfg: cfg}
+}
+
+// Run крутится до отмены ctx
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Пока пачки приходят полными, разгребаем очередь без пауз
+		for {
+			processed, err := r.ProcessBatch(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("outbox relay batch failed")
+				break
+			}
+			if processed < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch обрабатывает одну пачку событий и возвращает, сколько из них опубликовано.
+// Пачка, где все события ждут повтора, дает 0 — и Run не уходит в холостой цикл.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	var sent int
+
+	err := r.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		records, err := r.store.FetchPending(ctx, r.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		// Доски, на которых в этой пачке что-то не отправилось: их следующие события ждут
+		blocked := make(map[int64]bool)
+
+		for _, rec := range records {
+			if blocked[rec.BoardID] {
+				continue
+			}
+
+			earlier, err := r.store.HasEarlierPending(ctx, rec.BoardID, rec.ID)
+			if err != nil {
+				return err
+			}
+			if earlier {
+				blocked[rec.BoardID] = true
+				continue
+			}
+
+			if err := r.publish(ctx, rec); err != nil {
+				blocked[rec.BoardID] = true
+
+				attempts := rec.Attempts + 1
+				log.Warn().Err(err).Int64("outbox_id", rec.ID).Int("attempts", attempts).Msg("failed to publish outbox event")
+
+				if err := r.store.MarkFailed(ctx, rec.ID, attempts, time.Now().Add(r.backoff(attempts)), err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := r.store.MarkSent(ctx, rec.ID); err != nil {
+				return err
+			}
+			sent++
+		}
+
+		return nil
+	})
+
+	return sent, err
+}
+
+func (r *Relay) publish(ctx context.Context, rec Record) error {
+	value, err := json.Marshal(Envelope{
+		EventID:    rec.EventID,
+		EventType:  rec.EventType,
+		BoardID:    rec.BoardID,
+		OccurredAt: rec.CreatedAt,
+		Payload:    rec.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return r.publisher.Publish(ctx, Message{
+		Key:   strconv.FormatInt(rec.BoardID, 10),
+		Value: value,
+	})
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.cfg.MinBackoff
+	for i := 1; i < attempts && delay < r.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.cfg.MaxBackoff)
+}
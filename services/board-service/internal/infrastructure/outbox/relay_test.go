@@ -0,0 +1,255 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type noTx struct{}
+
+func (noTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type failure struct {
+	attempts      int
+	nextAttemptAt time.Time
+	reason        string
+}
+
+// memoryStore — outbox_events в памяти. locked — строки, заблокированные другим
+// экземпляром релея: FetchPending их не отдает, но они остаются неотправленными
+type memoryStore struct {
+	records []Record
+	locked  map[int64]bool
+	sent    map[int64]bool
+	failed  map[int64]failure
+}
+
+func newMemoryStore(records ...Record) *memoryStore {
+	return &memoryStore{
+		records: records,
+		locked:  make(map[int64]bool),
+		sent:    make(map[int64]bool),
+		failed:  make(map[int64]failure),
+	}
+}
+
+func (s *memoryStore) FetchPending(ctx context.Context, limit int) ([]Record, error) {
+	var pending []Record
+	for _, rec := range s.records {
+		if len(pending) == limit {
+			break
+		}
+		if s.sent[rec.ID] || s.locked[rec.ID] {
+			continue
+		}
+		pending = append(pending, rec)
+	}
+	return pending, nil
+}
+
+func (s *memoryStore) HasEarlierPending(ctx context.Context, boardID, id int64) (bool, error) {
+	for _, rec := range s.records {
+		if rec.BoardID == boardID && rec.ID < id && !s.sent[rec.ID] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *memoryStore) MarkSent(ctx context.Context, id int64) error {
+	s.sent[id] = true
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, reason string) error {
+	s.failed[id] = failure{attempts: attempts, nextAttemptAt: nextAttemptAt, reason: reason}
+	return nil
+}
+
+func record(id, boardID int64) Record {
+	return Record{
+		ID:        id,
+		EventID:   "event-" + strconv.FormatInt(id, 10),
+		BoardID:   boardID,
+		EventType: "TaskCreated",
+		Payload:   []byte(`{}`),
+		CreatedAt: time.Now(),
+	}
+}
+
+func testConfig() Config {
+	return Config{PollInterval: time.Second, BatchSize: 10, MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+}
+
+// publishedIDs достает id событий из опубликованных конвертов
+func publishedIDs(t *testing.T, p *MemoryPublisher) []string {
+	t.Helper()
+
+	var ids []string
+	for _, msg := range p.Messages() {
+		var env Envelope
+		if err := json.Unmarshal(msg.Value, &env); err != nil {
+			t.Fatalf("invalid envelope: %v", err)
+		}
+		if msg.Key != strconv.FormatInt(env.BoardID, 10) {
+			t.Fatalf("message key %q does not match board %d", msg.Key, env.BoardID)
+		}
+		ids = append(ids, env.EventID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRelayPublishesInOrder(t *testing.T) {
+	store := newMemoryStore(record(1, 10), record(2, 20), record(3, 10))
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(noTx{}, store, publisher, testConfig())
+
+	sent, err := relay.ProcessBatch(context.Background())
+	if err != nil {
+		t.Fatalf("ProcessBatch: %v", err)
+	}
+
+	if sent != 3 {
+		t.Fatalf("sent = %d, want 3", sent)
+	}
+
+	want := []string{"event-1", "event-2", "event-3"}
+	if got := publishedIDs(t, publisher); !equalIDs(got, want) {
+		t.Fatalf("published %v, want %v", got, want)
+	}
+
+	for _, id := range []int64{1, 2, 3} {
+		if !store.sent[id] {
+			t.Errorf("event %d is not marked as sent", id)
+		}
+	}
+}
+
+func TestRelayWaitsForEarlierEventOfSameBoard(t *testing.T) {
+	// Событие 1 доски 10 держит другой экземпляр релея: события 2 и 4 той же доски
+	// ждут его, а доска 20 не блокируется
+	store := newMemoryStore(record(1, 10), record(2, 10), record(3, 20), record(4, 10))
+	store.locked[1] = true
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(noTx{}, store, publisher, testConfig())
+
+	sent, err := relay.ProcessBatch(context.Background())
+	if err != nil {
+		t.Fatalf("ProcessBatch: %v", err)
+	}
+
+	if sent != 1 {
+		t.Fatalf("sent = %d, want 1", sent)
+	}
+
+	if got := publishedIDs(t, publisher); !equalIDs(got, []string{"event-3"}) {
+		t.Fatalf("published %v, want [event-3]", got)
+	}
+
+	// Другой релей отправил событие 1 — очередь доски 10 продолжается по порядку
+	store.locked[1] = false
+	store.sent[1] = true
+
+	if _, err := relay.ProcessBatch(context.Background()); err != nil {
+		t.Fatalf("ProcessBatch: %v", err)
+	}
+
+	want := []string{"event-3", "event-2", "event-4"}
+	if got := publishedIDs(t, publisher); !equalIDs(got, want) {
+		t.Fatalf("published %v, want %v", got, want)
+	}
+}
+
+func TestRelayMarksFailedAndBlocksBoard(t *testing.T) {
+	store := newMemoryStore(record(1, 10), record(2, 10), record(3, 20))
+	store.records[0].Attempts = 2
+
+	publisher := NewMemoryPublisher()
+	brokerDown := errors.New("broker is down")
+	publisher.FailWith(func(msg Message) error {
+		if msg.Key == "10" {
+			return brokerDown
+		}
+		return nil
+	})
+
+	relay := NewRelay(noTx{}, store, publisher, testConfig())
+
+	before := time.Now()
+	sent, err := relay.ProcessBatch(context.Background())
+	if err != nil {
+		t.Fatalf("ProcessBatch: %v", err)
+	}
+
+	if sent != 1 {
+		t.Fatalf("sent = %d, want 1", sent)
+	}
+
+	f, ok := store.failed[1]
+	if !ok {
+		t.Fatal("event 1 is not marked as failed")
+	}
+	if f.attempts != 3 {
+		t.Errorf("attempts = %d, want 3", f.attempts)
+	}
+	if f.reason != brokerDown.Error() {
+		t.Errorf("reason = %q, want %q", f.reason, brokerDown.Error())
+	}
+	// Третья попытка: MinBackoff * 2^2
+	if delay := f.nextAttemptAt.Sub(before); delay < 4*time.Second || delay > 5*time.Second {
+		t.Errorf("next attempt in %v, want about 4s", delay)
+	}
+
+	// Событие 2 той же доски не публикуется и не считается неудачным: оно просто ждет
+	if _, ok := store.failed[2]; ok {
+		t.Error("event 2 must wait for event 1, not fail")
+	}
+	if store.sent[2] {
+		t.Error("event 2 must not be sent before event 1")
+	}
+
+	if got := publishedIDs(t, publisher); !equalIDs(got, []string{"event-3"}) {
+		t.Fatalf("published %v, want [event-3]", got)
+	}
+}
+
+func TestRelayBackoff(t *testing.T) {
+	relay := NewRelay(noTx{}, newMemoryStore(), NewMemoryPublisher(), testConfig())
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 50, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := relay.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
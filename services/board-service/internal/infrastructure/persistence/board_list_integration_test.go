@@ -0,0 +1,83 @@
+//go:build integration
+
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/infrastructure/persistence"
+)
+
+// TestListBoardsArchiveFilter проверяет условия archived_at и deleted_at в BoardRepository.List
+func TestListBoardsArchiveFilter(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	boards := persistence.NewBoardRepository(db)
+	members := persistence.NewMemberRepository(db)
+
+	const memberID = seedUserID + 1
+	if _, err := db.Exec(ctx, "INSERT INTO users (id, email, username, password_hash) VALUES ($1, 'member@example.com', 'member', '')", memberID); err != nil {
+		t.Fatalf("create member user: %v", err)
+	}
+
+	create := func(title string, state func(b *board.Board) error) {
+		t.Helper()
+
+		b, err := board.NewBoard(title, "", seedUserID)
+		if err != nil {
+			t.Fatalf("new board: %v", err)
+		}
+		if err := boards.Create(ctx, b); err != nil {
+			t.Fatalf("create board: %v", err)
+		}
+		for userID, role := range map[int64]board.Role{seedUserID: board.RoleOwner, memberID: board.RoleEditor} {
+			if err := members.Create(ctx, &board.Member{BoardID: b.ID, UserID: userID, Role: role}); err != nil {
+				t.Fatalf("add member: %v", err)
+			}
+		}
+		if err := state(b); err != nil {
+			t.Fatalf("change state: %v", err)
+		}
+		if _, err := boards.Update(ctx, b); err != nil {
+			t.Fatalf("update board: %v", err)
+		}
+	}
+
+	now := time.Now()
+	create("Active", func(b *board.Board) error { return nil })
+	create("Archived", func(b *board.Board) error { return b.Archive(now) })
+	create("Deleted", func(b *board.Board) error { return b.SoftDelete(now) })
+
+	tests := []struct {
+		name   string
+		userID int64
+		filter board.ArchiveFilter
+		want   []string
+	}{
+		{name: "active", userID: seedUserID, filter: board.ArchiveFilterActive, want: []string{"Active"}},
+		{name: "archived", userID: seedUserID, filter: board.ArchiveFilterArchived, want: []string{"Archived"}},
+		{name: "all", userID: seedUserID, filter: board.ArchiveFilterAll, want: []string{"Active", "Archived"}},
+		{name: "deleted by owner", userID: seedUserID, filter: board.ArchiveFilterDeleted, want: []string{"Deleted"}},
+		{name: "deleted by member", userID: memberID, filter: board.ArchiveFilterDeleted, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := boards.List(ctx, board.ListQuery{UserID: tt.userID, Archived: tt.filter, SortBy: board.SortByCreatedAt, Limit: 10})
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+
+			var got []string
+			for _, b := range list {
+				got = append(got, b.Title)
+			}
+			if !equal(got, tt.want) {
+				t.Errorf("boards = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,8 +12,12 @@ type BoardModel struct {
 	Title       string         `json:"title" db:"title" example:"Daily routine"`
 	Description sql.NullString `json:"description" db:"description" example:"Board description"`
 	Owner       int64          `json:"owner" db:"user_id" example:"1"`
+	Version     int64          `json:"version" db:"version" example:"1"`
 	CreatedAt   time.Time      `json:"createdAt" db:"created_at" example:"2019-09-07 17:40:58"`
 	UpdatedAt   time.Time      `json:"updatedAt" db:"updated_at" example:"2019-09-07 17:40:58"`
+	ArchivedAt  *time.Time     `json:"archivedAt" db:"archived_at"`
+	DeletedAt   *time.Time     `json:"deletedAt" db:"deleted_at"`
+	IsTemplate  bool           `json:"isTemplate" db:"is_template"`
 }
 
 func (m *BoardModel) toDomain() *board.Board {
@@ -22,8 +26,12 @@ func (m *BoardModel) toDomain() *board.Board {
 		Title:       m.Title,
 		Description: m.Description.String,
 		Owner:       m.Owner,
+		Version:     m.Version,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		ArchivedAt:  m.ArchivedAt,
+		DeletedAt:   m.DeletedAt,
+		IsTemplate:  m.IsTemplate,
 	}
 }
 
@@ -35,8 +43,12 @@ func fromDomain(b *board.Board) *BoardModel {
 			String: b.Description,
 			Valid:  b.Description != "",
 		},
-		Owner:     b.Owner,
-		CreatedAt: b.CreatedAt,
-		UpdatedAt: b.UpdatedAt,
+		Owner:      b.Owner,
+		Version:    b.Version,
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+		ArchivedAt: b.ArchivedAt,
+		DeletedAt:  b.DeletedAt,
+		IsTemplate: b.IsTemplate,
 	}
 }
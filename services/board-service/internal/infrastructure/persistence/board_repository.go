@@ -2,9 +2,10 @@ package persistence
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"Taskify/services/board-service/internal/domain/board"
 
@@ -14,6 +15,8 @@ import (
 
 var _ board.Repository = (*BoardRepository)(nil)
 
+const boardColumns = "id, title, description, user_id, version, created_at, updated_at, archived_at, deleted_at, is_template"
+
 type BoardRepository struct {
 	db *pgxpool.Pool
 }
@@ -23,27 +26,29 @@ func NewBoardRepository(db *pgxpool.Pool) *BoardRepository {
 }
 
 func (r *BoardRepository) Create(ctx context.Context, b *board.Board) error {
-	query := "INSERT INTO boards(title, description, user_id) VALUES ($1, $2, $3) RETURNING id"
+	query := `INSERT INTO boards(title, description, user_id, is_template, archived_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, version`
 
 	model := fromDomain(b)
 
-	err := r.db.QueryRow(ctx, query, model.Title, model.Description, model.Owner).Scan(&model.ID)
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		model.Title, model.Description, model.Owner, model.IsTemplate, model.ArchivedAt, model.CreatedAt, model.UpdatedAt,
+	).Scan(&model.ID, &model.Version)
 	if err != nil {
 		// Здесь можно залогировать или обернуть ошибку
 		return fmt.Errorf("failed to create board: %w", err)
 	}
 
 	b.ID = model.ID
+	b.Version = model.Version
 
 	return nil
 }
 
 func (r *BoardRepository) GetByID(ctx context.Context, id int64) (*board.Board, error) {
-	query := "SELECT id, title, description, user_id, created_at, updated_at FROM boards WHERE id = $1"
-
-	var model BoardModel
+	query := "SELECT " + boardColumns + " FROM boards WHERE id = $1 AND deleted_at IS NULL"
 
-	err := r.db.QueryRow(ctx, query, id).Scan(&model.ID, &model.Title, &model.Description, &model.Owner, &model.CreatedAt, &model.UpdatedAt)
+	model, err := scanBoard(conn(ctx, r.db).QueryRow(ctx, query, id))
 	if err != nil {
 		// 3. Обрабатываем случай, когда запись не найдена
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -56,10 +61,75 @@ func (r *BoardRepository) GetByID(ctx context.Context, id int64) (*board.Board,
 	return model.toDomain(), nil
 }
 
-func (r *BoardRepository) GetList(ctx context.Context) ([]*board.Board, error) {
-	query := "SELECT id, title, description, user_id, created_at, updated_at FROM boards"
+// GetByIDForUpdate блокирует строку доски до конца транзакции.
+// Через неё сериализуются все изменения порядка внутри одной доски.
+func (r *BoardRepository) GetByIDForUpdate(ctx context.Context, id int64) (*board.Board, error) {
+	return r.lock(ctx, "SELECT "+boardColumns+" FROM boards WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", id)
+}
+
+func (r *BoardRepository) GetAnyByIDForUpdate(ctx context.Context, id int64) (*board.Board, error) {
+	return r.lock(ctx, "SELECT "+boardColumns+" FROM boards WHERE id = $1 FOR UPDATE", id)
+}
+
+func (r *BoardRepository) lock(ctx context.Context, query string, id int64) (*board.Board, error) {
+	model, err := scanBoard(conn(ctx, r.db).QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("failed to lock board: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// archiveConditions — условие на archived_at и deleted_at для каждого фильтра.
+// Удаленные доски показываются только владельцу: восстановить их может лишь он
+var archiveConditions = map[board.ArchiveFilter]string{
+	board.ArchiveFilterActive:   "b.deleted_at IS NULL AND b.archived_at IS NULL",
+	board.ArchiveFilterArchived: "b.deleted_at IS NULL AND b.archived_at IS NOT NULL",
+	board.ArchiveFilterAll:      "b.deleted_at IS NULL",
+	board.ArchiveFilterDeleted:  "b.deleted_at IS NOT NULL AND b.user_id = m.user_id",
+}
+
+// sortColumns — белый список колонок сортировки: имя колонки подставляется в SQL как есть
+var sortColumns = map[board.SortField]string{
+	board.SortByCreatedAt: "b.created_at",
+	board.SortByUpdatedAt: "b.updated_at",
+}
+
+func (r *BoardRepository) List(ctx context.Context, q board.ListQuery) ([]*board.Board, error) {
+	column, ok := sortColumns[q.SortBy]
+	if !ok {
+		return nil, board.ErrInvalidSort
+	}
+
+	direction, cmp := "ASC", ">"
+	if q.Descending {
+		direction, cmp = "DESC", "<"
+	}
+
+	archived, ok := archiveConditions[q.Archived]
+	if !ok {
+		return nil, board.ErrInvalidArchiveFilter
+	}
 
-	rows, err := r.db.Query(ctx, query)
+	// Доступ дает строка в board_members: владелец тоже хранится там
+	query := `SELECT b.id, b.title, b.description, b.user_id, b.version, b.created_at, b.updated_at, b.archived_at, b.deleted_at, b.is_template
+		FROM boards b
+		JOIN board_members m ON m.board_id = b.id AND m.user_id = $1
+		WHERE ` + archived + ` AND ($2 = '' OR b.title ILIKE '%' || $2 || '%' ESCAPE '\')`
+	args := []any{q.UserID, escapeLike(q.Search)}
+
+	if q.After != nil {
+		// Сравнение кортежей продолжает список ровно с места курсора, даже при равных временах
+		query += fmt.Sprintf(" AND (%s, b.id) %s ($3, $4)", column, cmp)
+		args = append(args, q.After.Value, q.After.ID)
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s %s, b.id %s LIMIT %d", column, direction, direction, q.Limit)
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query boards: %w", err)
 	}
@@ -67,18 +137,11 @@ func (r *BoardRepository) GetList(ctx context.Context) ([]*board.Board, error) {
 	defer rows.Close()
 
 	// Инициализируем слайс, чтобы он был [] (empty json), а не null, если записей нет
-	boardsList := make([]*board.Board, 0)
+	boardsList := make([]*board.Board, 0, q.Limit)
 
 	for rows.Next() {
-		var model BoardModel
-		if err := rows.Scan(
-			&model.ID,
-			&model.Title,
-			&model.Description,
-			&model.Owner,
-			&model.CreatedAt,
-			&model.UpdatedAt,
-		); err != nil {
+		model, err := scanBoard(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan board: %w", err)
 		}
 
@@ -94,43 +157,118 @@ func (r *BoardRepository) GetList(ctx context.Context) ([]*board.Board, error) {
 	return boardsList, nil
 }
 
-func (r *BoardRepository) Update(ctx context.Context, b *board.Board) (*board.Board, error) {
-	query := "UPDATE boards SET title = $1, description = $2, updated_at = $3 WHERE id = $4 RETURNING id, title, description, user_id, created_at, updated_at"
+func (r *BoardRepository) ListTemplates(ctx context.Context, userID int64) ([]*board.Board, error) {
+	query := `SELECT b.id, b.title, b.description, b.user_id, b.version, b.created_at, b.updated_at, b.archived_at, b.deleted_at, b.is_template
+		FROM boards b
+		JOIN board_members m ON m.board_id = b.id AND m.user_id = $1
+		WHERE b.is_template AND b.deleted_at IS NULL
+		ORDER BY b.title, b.id`
 
-	// Конвертируем string -> sql.NullString для description
-	desc := sql.NullString{String: b.Description, Valid: b.Description != ""}
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query board templates: %w", err)
+	}
+	defer rows.Close()
 
-	var model BoardModel
+	templates := make([]*board.Board, 0)
 
-	err := r.db.QueryRow(ctx, query, b.Title, desc, b.UpdatedAt, b.ID).Scan(
-		&model.ID,
-		&model.Title,       // <--- добавил &
-		&model.Description, // <--- добавил &
-		&model.Owner,       // <--- добавил &
-		&model.CreatedAt,
-		&model.UpdatedAt,
-	)
+	for rows.Next() {
+		model, err := scanBoard(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan board template: %w", err)
+		}
+
+		templates = append(templates, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return templates, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike экранирует спецсимволы LIKE, чтобы поиск шел по буквальной подстроке
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// Update сохраняет доску, только если её версия в БД все еще равна b.Version,
+// и увеличивает версию. Иначе доску успели изменить — возвращается ErrVersionConflict.
+func (r *BoardRepository) Update(ctx context.Context, b *board.Board) (*board.Board, error) {
+	query := `UPDATE boards SET title = $1, description = $2, user_id = $3, updated_at = $4, archived_at = $5, deleted_at = $6, is_template = $7,
+			version = version + 1
+		WHERE id = $8 AND version = $9
+		RETURNING ` + boardColumns
+
+	model := fromDomain(b)
+
+	updated, err := scanBoard(conn(ctx, r.db).QueryRow(ctx, query,
+		model.Title, model.Description, model.Owner, model.UpdatedAt, model.ArchivedAt, model.DeletedAt, model.IsTemplate, model.ID, model.Version,
+	))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrVersionConflict
+		}
 		return nil, fmt.Errorf("failed to update board: %w", err)
 	}
 
-	return model.toDomain(), nil
+	return updated.toDomain(), nil
 }
 
-func (r *BoardRepository) Delete(ctx context.Context, id int64) error {
-	query := "DELETE FROM boards WHERE id = $1"
+func (r *BoardRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	// SKIP LOCKED: доску, которую прямо сейчас восстанавливают, не трогаем — заберем в следующий раз.
+	// Колонки, задачи и участники удаляются каскадом
+	query := `DELETE FROM boards WHERE id IN (
+			SELECT id FROM boards WHERE deleted_at IS NOT NULL AND deleted_at <= $1
+			ORDER BY deleted_at LIMIT $2 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id`
 
-	// Выполняем запрос и получаем tag команды (информацию о результате)
-	commandTag, err := r.db.Exec(ctx, query, id)
+	rows, err := conn(ctx, r.db).Query(ctx, query, before, limit)
 	if err != nil {
-		return fmt.Errorf("failed to delete board: %w", err)
+		return nil, fmt.Errorf("failed to purge boards: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan purged board id: %w", err)
+		}
+
+		ids = append(ids, id)
 	}
 
-	// Проверяем, сколько строк было удалено
-	if commandTag.RowsAffected() == 0 {
-		// Если 0, значит доски с таким ID не было
-		return board.ErrBoardNotFound
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	return nil
+	return ids, nil
+}
+
+func scanBoard(row pgx.Row) (*BoardModel, error) {
+	var model BoardModel
+
+	err := row.Scan(
+		&model.ID,
+		&model.Title,
+		&model.Description,
+		&model.Owner,
+		&model.Version,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+		&model.ArchivedAt,
+		&model.DeletedAt,
+		&model.IsTemplate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model, nil
 }
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type ColumnModel struct {
+	ID        int64     `db:"id"`
+	BoardID   int64     `db:"board_id"`
+	Title     string    `db:"title"`
+	Position  int       `db:"position"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (m *ColumnModel) toDomain() *board.Column {
+	return &board.Column{
+		ID:        m.ID,
+		BoardID:   m.BoardID,
+		Title:     m.Title,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.ColumnRepository = (*ColumnRepository)(nil)
+
+type ColumnRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewColumnRepository(db *pgxpool.Pool) *ColumnRepository {
+	return &ColumnRepository{db: db}
+}
+
+func (r *ColumnRepository) Create(ctx context.Context, c *board.Column) error {
+	query := `INSERT INTO board_columns(board_id, title, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, c.BoardID, c.Title, c.Position, c.CreatedAt, c.UpdatedAt).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create column: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ColumnRepository) GetByID(ctx context.Context, id int64) (*board.Column, error) {
+	query := "SELECT id, board_id, title, position, created_at, updated_at FROM board_columns WHERE id = $1"
+
+	var model ColumnModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(&model.ID, &model.BoardID, &model.Title, &model.Position, &model.CreatedAt, &model.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrColumnNotFound
+		}
+		return nil, fmt.Errorf("failed to get column: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *ColumnRepository) ListByBoard(ctx context.Context, boardID int64) ([]*board.Column, error) {
+	query := "SELECT id, board_id, title, position, created_at, updated_at FROM board_columns WHERE board_id = $1 ORDER BY position"
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+	defer rows.Close()
+
+	columns := make([]*board.Column, 0)
+
+	for rows.Next() {
+		var model ColumnModel
+		if err := rows.Scan(&model.ID, &model.BoardID, &model.Title, &model.Position, &model.CreatedAt, &model.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+
+		columns = append(columns, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return columns, nil
+}
+
+func (r *ColumnRepository) CountByBoard(ctx context.Context, boardID int64) (int, error) {
+	query := "SELECT COUNT(*) FROM board_columns WHERE board_id = $1"
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, boardID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count columns: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *ColumnRepository) Update(ctx context.Context, c *board.Column) (*board.Column, error) {
+	query := "UPDATE board_columns SET title = $1, position = $2, updated_at = $3 WHERE id = $4 RETURNING id, board_id, title, position, created_at, updated_at"
+
+	var model ColumnModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, c.Title, c.Position, c.UpdatedAt, c.ID).Scan(
+		&model.ID,
+		&model.BoardID,
+		&model.Title,
+		&model.Position,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrColumnNotFound
+		}
+		return nil, fmt.Errorf("failed to update column: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *ColumnRepository) ShiftPositions(ctx context.Context, boardID int64, from, to, delta int) error {
+	query := "UPDATE board_columns SET position = position + $1 WHERE board_id = $2 AND position BETWEEN $3 AND $4"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, delta, boardID, from, to); err != nil {
+		return fmt.Errorf("failed to shift column positions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ColumnRepository) Delete(ctx context.Context, id int64) error {
+	query := "DELETE FROM board_columns WHERE id = $1"
+
+	commandTag, err := conn(ctx, r.db).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete column: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return board.ErrColumnNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.IdempotencyRepository = (*IdempotencyRepository)(nil)
+
+type IdempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, rec *board.IdempotencyRecord) (bool, error) {
+	// Истекший ключ переиспользуется как новый, действующий остается нетронутым
+	query := `INSERT INTO idempotency_keys(user_id, operation, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, operation, key) DO UPDATE
+			SET request_hash = EXCLUDED.request_hash, response = NULL, created_at = NOW(), expires_at = EXCLUDED.expires_at
+			WHERE idempotency_keys.expires_at <= NOW()
+		RETURNING user_id`
+
+	var userID int64
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, rec.UserID, rec.Operation, rec.Key, rec.RequestHash, rec.ExpiresAt).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, userID int64, operation, key string) (*board.IdempotencyRecord, error) {
+	query := "SELECT user_id, operation, key, request_hash, response, expires_at FROM idempotency_keys WHERE user_id = $1 AND operation = $2 AND key = $3"
+
+	var rec board.IdempotencyRecord
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, userID, operation, key).Scan(&rec.UserID, &rec.Operation, &rec.Key, &rec.RequestHash, &rec.Response, &rec.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &rec, nil
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, userID int64, operation, key string, response []byte) error {
+	query := "UPDATE idempotency_keys SET response = $4 WHERE user_id = $1 AND operation = $2 AND key = $3"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, userID, operation, key, response); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := "DELETE FROM idempotency_keys WHERE expires_at <= $1"
+
+	tag, err := conn(ctx, r.db).Exec(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,145 @@
+//go:build integration
+
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+	"Taskify/services/board-service/internal/infrastructure/persistence"
+	"Taskify/services/board-service/internal/infrastructure/token"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+// noMailer — почта, которая ничего не отправляет
+type noMailer struct{}
+
+func (noMailer) SendInvitation(ctx context.Context, invitation *board.Invitation, token string) error {
+	return nil
+}
+
+// TestAcceptInvitationConcurrent принимает приглашение одновременно с его отзывом
+// или повторным приглашением на тот же адрес. Все три сценария блокируют сначала доску,
+// потом приглашение, поэтому транзакции выстраиваются в очередь, а не падают
+// с deadlock_detected. Результат согласован: участником становится только тот,
+// чье приглашение осталось принятым.
+func TestAcceptInvitationConcurrent(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+
+	tx := persistence.NewTxManager(db)
+	boards := persistence.NewBoardRepository(db)
+	members := persistence.NewMemberRepository(db)
+	invitations := persistence.NewInvitationRepository(db)
+	tokens := token.NewInvitationTokens()
+	auth := usecase.NewAuthorizer(members)
+
+	accept := usecase.NewAcceptInvitationUseCase(tx, invitations, members, persistence.NewUserRepository(db), tokens, boards)
+	revoke := usecase.NewRevokeInvitationUseCase(tx, boards, invitations, auth)
+	create := usecase.NewCreateInvitationUseCase(tx, boards, invitations, tokens, noMailer{}, auth, time.Hour)
+
+	b, err := board.NewBoard("Board", "", seedUserID)
+	if err != nil {
+		t.Fatalf("new board: %v", err)
+	}
+	if err := boards.Create(ctx, b); err != nil {
+		t.Fatalf("create board: %v", err)
+	}
+
+	owner := identity.WithUserID(ctx, seedUserID)
+
+	tests := []struct {
+		name string
+		// concurrent — действие владельца, идущее одновременно с принятием
+		concurrent func(email string, invitationID int64) error
+		// acceptErr и concurrentErr — допустимые ошибки той стороны, что не успела первой
+		acceptErr, concurrentErr error
+	}{
+		{
+			name: "revoke",
+			concurrent: func(_ string, invitationID int64) error {
+				return revoke.Handle(owner, usecase.RevokeInvitationCommand{BoardID: b.ID, InvitationID: invitationID})
+			},
+			acceptErr:     board.ErrInvitationRevoked,
+			concurrentErr: board.ErrInvitationAlreadyUsed,
+		},
+		{
+			name: "re-invite",
+			concurrent: func(email string, _ int64) error {
+				_, err := create.Handle(owner, usecase.CreateInvitationCommand{BoardID: b.ID, Email: email, Role: string(board.RoleViewer)})
+				return err
+			},
+			acceptErr: board.ErrInvitationRevoked,
+		},
+	}
+
+	const rounds = 20
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for round := range rounds {
+				email := fmt.Sprintf("%s-%d@example.com", tt.name, round)
+
+				var userID int64
+				err := db.QueryRow(ctx, "INSERT INTO users (email, username, password_hash) VALUES ($1, $1, 'x') RETURNING id", email).Scan(&userID)
+				if err != nil {
+					t.Fatalf("create user: %v", err)
+				}
+
+				raw, hash, err := tokens.Generate()
+				if err != nil {
+					t.Fatalf("generate token: %v", err)
+				}
+				invitation, err := board.NewInvitation(b.ID, email, board.RoleEditor, seedUserID, hash, time.Hour)
+				if err != nil {
+					t.Fatalf("new invitation: %v", err)
+				}
+				if err := invitations.Create(ctx, invitation); err != nil {
+					t.Fatalf("create invitation: %v", err)
+				}
+
+				var (
+					wg                       sync.WaitGroup
+					start                    = make(chan struct{})
+					acceptErr, concurrentErr error
+				)
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					<-start
+					_, acceptErr = accept.Handle(identity.WithUserID(ctx, userID), raw)
+				}()
+				go func() {
+					defer wg.Done()
+					<-start
+					concurrentErr = tt.concurrent(email, invitation.ID)
+				}()
+				close(start)
+				wg.Wait()
+
+				if acceptErr != nil && !errors.Is(acceptErr, tt.acceptErr) {
+					t.Fatalf("round %d: accept: %v", round, acceptErr)
+				}
+				if concurrentErr != nil && !errors.Is(concurrentErr, tt.concurrentErr) {
+					t.Fatalf("round %d: %s: %v", round, tt.name, concurrentErr)
+				}
+
+				stored, err := invitations.GetByID(ctx, invitation.ID)
+				if err != nil {
+					t.Fatalf("get invitation: %v", err)
+				}
+				_, memberErr := members.Get(ctx, b.ID, userID)
+				accepted := acceptErr == nil
+				if (stored.AcceptedAt != nil) != accepted || (memberErr == nil) != accepted {
+					t.Fatalf("round %d: accept err = %v, accepted at = %v, member err = %v", round, acceptErr, stored.AcceptedAt, memberErr)
+				}
+			}
+		})
+	}
+}
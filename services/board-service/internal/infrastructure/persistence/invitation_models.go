@@ -0,0 +1,42 @@
+package persistence
+
+import (
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type InvitationModel struct {
+	ID         int64      `db:"id"`
+	BoardID    int64      `db:"board_id"`
+	Email      string     `db:"email"`
+	Role       string     `db:"role"`
+	TokenHash  string     `db:"token_hash"`
+	InvitedBy  int64      `db:"invited_by"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	AcceptedAt *time.Time `db:"accepted_at"`
+	AcceptedBy *int64     `db:"accepted_by"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+func (m *InvitationModel) toDomain() *board.Invitation {
+	inv := &board.Invitation{
+		ID:         m.ID,
+		BoardID:    m.BoardID,
+		Email:      m.Email,
+		Role:       board.Role(m.Role),
+		TokenHash:  m.TokenHash,
+		InvitedBy:  m.InvitedBy,
+		ExpiresAt:  m.ExpiresAt,
+		AcceptedAt: m.AcceptedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+
+	if m.AcceptedBy != nil {
+		inv.AcceptedBy = *m.AcceptedBy
+	}
+
+	return inv
+}
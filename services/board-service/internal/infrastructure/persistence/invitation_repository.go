@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.InvitationRepository = (*InvitationRepository)(nil)
+
+const invitationColumns = "id, board_id, email, role, token_hash, invited_by, expires_at, accepted_at, accepted_by, revoked_at, created_at"
+
+type InvitationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewInvitationRepository(db *pgxpool.Pool) *InvitationRepository {
+	return &InvitationRepository{db: db}
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, inv *board.Invitation) error {
+	query := `INSERT INTO board_invitations(board_id, email, role, token_hash, invited_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, inv.BoardID, inv.Email, string(inv.Role), inv.TokenHash, inv.InvitedBy, inv.ExpiresAt).Scan(&inv.ID, &inv.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) GetByID(ctx context.Context, id int64) (*board.Invitation, error) {
+	query := "SELECT " + invitationColumns + " FROM board_invitations WHERE id = $1"
+
+	return r.getOne(ctx, query, id)
+}
+
+func (r *InvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*board.Invitation, error) {
+	query := "SELECT " + invitationColumns + " FROM board_invitations WHERE token_hash = $1"
+
+	return r.getOne(ctx, query, tokenHash)
+}
+
+func (r *InvitationRepository) GetByTokenHashForUpdate(ctx context.Context, tokenHash string) (*board.Invitation, error) {
+	query := "SELECT " + invitationColumns + " FROM board_invitations WHERE token_hash = $1 FOR UPDATE"
+
+	return r.getOne(ctx, query, tokenHash)
+}
+
+func (r *InvitationRepository) ListPendingByBoard(ctx context.Context, boardID int64, now time.Time) ([]*board.Invitation, error) {
+	query := "SELECT " + invitationColumns + ` FROM board_invitations
+		WHERE board_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY created_at, id`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, boardID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invitations: %w", err)
+	}
+	defer rows.Close()
+
+	invitations := make([]*board.Invitation, 0)
+
+	for rows.Next() {
+		model, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+
+		invitations = append(invitations, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (r *InvitationRepository) RevokePending(ctx context.Context, boardID int64, email string, now time.Time) (int64, error) {
+	query := `UPDATE board_invitations SET revoked_at = $3
+		WHERE board_id = $1 AND email = $2 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > $3`
+
+	tag, err := conn(ctx, r.db).Exec(ctx, query, boardID, email, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke invitations: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *InvitationRepository) Update(ctx context.Context, inv *board.Invitation) error {
+	query := "UPDATE board_invitations SET accepted_at = $2, accepted_by = $3, revoked_at = $4 WHERE id = $1"
+
+	var acceptedBy *int64
+	if inv.AcceptedBy != 0 {
+		acceptedBy = &inv.AcceptedBy
+	}
+
+	tag, err := conn(ctx, r.db).Exec(ctx, query, inv.ID, inv.AcceptedAt, acceptedBy, inv.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return board.ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) getOne(ctx context.Context, query string, arg any) (*board.Invitation, error) {
+	model, err := scanInvitation(conn(ctx, r.db).QueryRow(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func scanInvitation(row pgx.Row) (*InvitationModel, error) {
+	var model InvitationModel
+
+	err := row.Scan(
+		&model.ID,
+		&model.BoardID,
+		&model.Email,
+		&model.Role,
+		&model.TokenHash,
+		&model.InvitedBy,
+		&model.ExpiresAt,
+		&model.AcceptedAt,
+		&model.AcceptedBy,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
@@ -0,0 +1,25 @@
+package persistence
+
+import (
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type MemberModel struct {
+	BoardID   int64     `db:"board_id"`
+	UserID    int64     `db:"user_id"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (m *MemberModel) toDomain() *board.Member {
+	return &board.Member{
+		BoardID:   m.BoardID,
+		UserID:    m.UserID,
+		Role:      board.Role(m.Role),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.MemberRepository = (*MemberRepository)(nil)
+
+type MemberRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMemberRepository(db *pgxpool.Pool) *MemberRepository {
+	return &MemberRepository{db: db}
+}
+
+func (r *MemberRepository) Create(ctx context.Context, m *board.Member) error {
+	query := "INSERT INTO board_members(board_id, user_id, role) VALUES ($1, $2, $3) RETURNING created_at, updated_at"
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, m.BoardID, m.UserID, string(m.Role)).Scan(&m.CreatedAt, &m.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create board member: %w", mapMemberError(err))
+	}
+
+	return nil
+}
+
+func (r *MemberRepository) Get(ctx context.Context, boardID, userID int64) (*board.Member, error) {
+	query := "SELECT board_id, user_id, role, created_at, updated_at FROM board_members WHERE board_id = $1 AND user_id = $2"
+
+	var model MemberModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, boardID, userID).Scan(&model.BoardID, &model.UserID, &model.Role, &model.CreatedAt, &model.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get board member: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *MemberRepository) ListByBoard(ctx context.Context, boardID int64) ([]*board.Member, error) {
+	query := `SELECT board_id, user_id, role, created_at, updated_at FROM board_members
+		WHERE board_id = $1
+		ORDER BY array_position(ARRAY['owner', 'admin', 'editor', 'viewer'], role), created_at, user_id`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query board members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]*board.Member, 0)
+
+	for rows.Next() {
+		var model MemberModel
+		if err := rows.Scan(&model.BoardID, &model.UserID, &model.Role, &model.CreatedAt, &model.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan board member: %w", err)
+		}
+
+		members = append(members, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *MemberRepository) Update(ctx context.Context, m *board.Member) (*board.Member, error) {
+	query := `UPDATE board_members SET role = $3, updated_at = NOW()
+		WHERE board_id = $1 AND user_id = $2
+		RETURNING board_id, user_id, role, created_at, updated_at`
+
+	var model MemberModel
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, m.BoardID, m.UserID, string(m.Role)).Scan(&model.BoardID, &model.UserID, &model.Role, &model.CreatedAt, &model.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to update board member: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *MemberRepository) Save(ctx context.Context, m *board.Member) error {
+	query := `INSERT INTO board_members(board_id, user_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (board_id, user_id) DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()
+		RETURNING created_at, updated_at`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, m.BoardID, m.UserID, string(m.Role)).Scan(&m.CreatedAt, &m.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save board member: %w", mapMemberError(err))
+	}
+
+	return nil
+}
+
+func (r *MemberRepository) Delete(ctx context.Context, boardID, userID int64) error {
+	query := "DELETE FROM board_members WHERE board_id = $1 AND user_id = $2"
+
+	tag, err := conn(ctx, r.db).Exec(ctx, query, boardID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete board member: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return board.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// mapMemberError превращает нарушения ограничений board_members в доменные ошибки
+func mapMemberError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == "23505":
+		return board.ErrMemberAlreadyExists
+	case pgErr.Code == "23503" && pgErr.ConstraintName == "board_members_user_id_fkey":
+		return board.ErrUserNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,323 @@
+//go:build integration
+
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+	"Taskify/services/board-service/internal/infrastructure/persistence"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+// seedUserID — пользователь, которого создает миграция 001
+const seedUserID = 1
+
+// noCache — кэш, в котором ничего не хранится
+type noCache struct{}
+
+func (noCache) Get(ctx context.Context, boardID int64) (*usecase.CachedStructure, error) {
+	return nil, nil
+}
+
+func (noCache) Generation(ctx context.Context, boardID int64) (int64, error) { return 0, nil }
+
+func (noCache) Set(ctx context.Context, structure *board.Structure, generation int64) error {
+	return nil
+}
+
+func (noCache) Invalidate(ctx context.Context, boardID int64) error { return nil }
+
+type moveFixture struct {
+	db      *pgxpool.Pool
+	tx      *persistence.TxManager
+	boards  *persistence.BoardRepository
+	columns *persistence.ColumnRepository
+	tasks   *persistence.TaskRepository
+	move    *usecase.MoveTaskUseCase
+
+	board *board.Board
+	// Названия колонок -> колонки
+	column map[string]*board.Column
+	// Названия задач -> id
+	task map[string]int64
+}
+
+// newMoveFixture создает доску seedUserID с колонками A и B. В A задачи a0..a3, в B — b0..b2
+func newMoveFixture(t *testing.T) *moveFixture {
+	t.Helper()
+
+	db := testDB(t)
+	f := &moveFixture{
+		db:      db,
+		tx:      persistence.NewTxManager(db),
+		boards:  persistence.NewBoardRepository(db),
+		columns: persistence.NewColumnRepository(db),
+		tasks:   persistence.NewTaskRepository(db),
+		column:  make(map[string]*board.Column),
+		task:    make(map[string]int64),
+	}
+	members := persistence.NewMemberRepository(db)
+	f.move = usecase.NewMoveTaskUseCase(f.tx, f.boards, f.columns, f.tasks, persistence.NewOutboxRepository(db), noCache{}, usecase.NewAuthorizer(members))
+
+	ctx := context.Background()
+
+	b, err := board.NewBoard("Board", "", seedUserID)
+	if err != nil {
+		t.Fatalf("new board: %v", err)
+	}
+	if err := f.boards.Create(ctx, b); err != nil {
+		t.Fatalf("create board: %v", err)
+	}
+	f.board = b
+
+	seed := map[string][]string{
+		"A": {"a0", "a1", "a2", "a3"},
+		"B": {"b0", "b1", "b2"},
+	}
+	for i, name := range []string{"A", "B"} {
+		c, err := board.NewColumn(b.ID, name, i)
+		if err != nil {
+			t.Fatalf("new column: %v", err)
+		}
+		if err := f.columns.Create(ctx, c); err != nil {
+			t.Fatalf("create column: %v", err)
+		}
+		f.column[name] = c
+
+		for j, title := range seed[name] {
+			task, err := board.NewTask(c.ID, title, "", 0, j)
+			if err != nil {
+				t.Fatalf("new task: %v", err)
+			}
+			if err := f.tasks.Create(ctx, task); err != nil {
+				t.Fatalf("create task: %v", err)
+			}
+			f.task[title] = task.ID
+		}
+	}
+
+	return f
+}
+
+// titles возвращает задачи колонки по позиции и проверяет, что позиции идут подряд с нуля
+func (f *moveFixture) titles(t *testing.T, column string) []string {
+	t.Helper()
+
+	rows, err := f.db.Query(context.Background(), "SELECT title, position FROM tasks WHERE column_id = $1 ORDER BY position", f.column[column].ID)
+	if err != nil {
+		t.Fatalf("list tasks: %v", err)
+	}
+	defer rows.Close()
+
+	var titles []string
+	for rows.Next() {
+		var (
+			title    string
+			position int
+		)
+		if err := rows.Scan(&title, &position); err != nil {
+			t.Fatalf("scan task: %v", err)
+		}
+		if position != len(titles) {
+			t.Fatalf("column %s: task %s at position %d, want %d", column, title, position, len(titles))
+		}
+		titles = append(titles, title)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("list tasks: %v", err)
+	}
+
+	return titles
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMoveTask(t *testing.T) {
+	tests := []struct {
+		name     string
+		task     string
+		column   string
+		position int
+		wantA    []string
+		wantB    []string
+	}{
+		{name: "down within column", task: "a0", column: "A", position: 2, wantA: []string{"a1", "a2", "a0", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "up within column", task: "a3", column: "A", position: 1, wantA: []string{"a0", "a3", "a1", "a2"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the end of column", task: "a1", column: "A", position: 3, wantA: []string{"a0", "a2", "a3", "a1"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the start of column", task: "a2", column: "A", position: 0, wantA: []string{"a2", "a0", "a1", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "same position", task: "a1", column: "A", position: 1, wantA: []string{"a0", "a1", "a2", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the start of another column", task: "a1", column: "B", position: 0, wantA: []string{"a0", "a2", "a3"}, wantB: []string{"a1", "b0", "b1", "b2"}},
+		{name: "into the middle of another column", task: "a0", column: "B", position: 2, wantA: []string{"a1", "a2", "a3"}, wantB: []string{"b0", "b1", "a0", "b2"}},
+		{name: "to the end of another column", task: "a3", column: "B", position: 3, wantA: []string{"a0", "a1", "a2"}, wantB: []string{"b0", "b1", "b2", "a3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newMoveFixture(t)
+
+			moved, err := f.move.Handle(identity.WithUserID(context.Background(), seedUserID), usecase.MoveTaskCommand{
+				BoardID:  f.board.ID,
+				TaskID:   f.task[tt.task],
+				ColumnID: f.column[tt.column].ID,
+				Position: tt.position,
+			})
+			if err != nil {
+				t.Fatalf("move: %v", err)
+			}
+			if moved.ColumnID != f.column[tt.column].ID || moved.Position != tt.position {
+				t.Errorf("moved task at column %d position %d, want %d/%d", moved.ColumnID, moved.Position, f.column[tt.column].ID, tt.position)
+			}
+
+			if got := f.titles(t, "A"); !equal(got, tt.wantA) {
+				t.Errorf("column A = %v, want %v", got, tt.wantA)
+			}
+			if got := f.titles(t, "B"); !equal(got, tt.wantB) {
+				t.Errorf("column B = %v, want %v", got, tt.wantB)
+			}
+		})
+	}
+}
+
+func TestMoveTaskRejectsPositionOutOfRange(t *testing.T) {
+	f := newMoveFixture(t)
+	ctx := identity.WithUserID(context.Background(), seedUserID)
+
+	tests := []struct {
+		name     string
+		column   string
+		position int
+	}{
+		{name: "past the end of own column", column: "A", position: 4},
+		{name: "past the end of another column", column: "B", position: 4},
+		{name: "negative", column: "B", position: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.move.Handle(ctx, usecase.MoveTaskCommand{BoardID: f.board.ID, TaskID: f.task["a0"], ColumnID: f.column[tt.column].ID, Position: tt.position})
+			if !errors.Is(err, board.ErrInvalidPosition) {
+				t.Fatalf("err = %v, want ErrInvalidPosition", err)
+			}
+		})
+	}
+
+	// Отклоненные перемещения откатываются целиком
+	if got := f.titles(t, "A"); !equal(got, []string{"a0", "a1", "a2", "a3"}) {
+		t.Errorf("column A = %v after rejected moves", got)
+	}
+}
+
+// TestShiftPositionsUniqueIsDeferred проверяет, что UNIQUE (column_id, position)
+// проверяется на COMMIT: сдвиг позиций временно создает дубликаты
+func TestShiftPositionsUniqueIsDeferred(t *testing.T) {
+	f := newMoveFixture(t)
+	columnID := f.column["A"].ID
+
+	// Сдвиг a1..a3 на одну позицию вниз дает две задачи на позиции 1, пока a0 не переедет
+	err := f.tx.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := f.tasks.ShiftPositions(ctx, columnID, 1, 3, -1); err != nil {
+			return err
+		}
+
+		task, err := f.tasks.GetByID(ctx, f.task["a0"])
+		if err != nil {
+			return err
+		}
+		if err := task.MoveTo(columnID, 3); err != nil {
+			return err
+		}
+		_, err = f.tasks.Update(ctx, task)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("shift with temporary duplicates: %v", err)
+	}
+
+	if got := f.titles(t, "A"); !equal(got, []string{"a1", "a2", "a3", "a0"}) {
+		t.Fatalf("column A = %v", got)
+	}
+
+	// Если дубликат остается до конца транзакции, COMMIT её отклоняет
+	err = f.tx.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return f.tasks.ShiftPositions(ctx, columnID, 1, 1, -1)
+	})
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		t.Fatalf("commit with duplicate positions: err = %v, want unique violation", err)
+	}
+
+	if got := f.titles(t, "A"); !equal(got, []string{"a1", "a2", "a3", "a0"}) {
+		t.Fatalf("column A = %v after failed commit", got)
+	}
+}
+
+// TestMoveTaskConcurrent гоняет параллельные перемещения по одной доске.
+// Блокировка доски выстраивает их в очередь, поэтому ни одно не падает на UNIQUE,
+// а позиции в обеих колонках остаются непрерывными
+func TestMoveTaskConcurrent(t *testing.T) {
+	f := newMoveFixture(t)
+	ctx := identity.WithUserID(context.Background(), seedUserID)
+
+	titles := []string{"a0", "a1", "a2", "a3", "b0", "b1", "b2"}
+	columns := []string{"A", "B"}
+
+	const (
+		workers = 8
+		moves   = 20
+	)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*moves)
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			rnd := rand.New(rand.NewPCG(uint64(w), 0))
+			for range moves {
+				// Позиция 0 допустима всегда: в колонке назначения может не остаться задач,
+				// а в своей колонке задача есть как минимум одна — она сама
+				_, err := f.move.Handle(ctx, usecase.MoveTaskCommand{
+					BoardID:  f.board.ID,
+					TaskID:   f.task[titles[rnd.IntN(len(titles))]],
+					ColumnID: f.column[columns[rnd.IntN(len(columns))]].ID,
+					Position: 0,
+				})
+				if err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("concurrent move: %v", err)
+	}
+
+	// titles проверяет непрерывность позиций в каждой колонке
+	total := len(f.titles(t, "A")) + len(f.titles(t, "B"))
+	if total != len(titles) {
+		t.Fatalf("tasks on board = %d, want %d", total, len(titles))
+	}
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/infrastructure/outbox"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	_ usecase.Outbox = (*OutboxRepository)(nil)
+	_ outbox.Store   = (*OutboxRepository)(nil)
+)
+
+type OutboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Save пишет события через транзакцию из контекста, поэтому должен
+// вызываться внутри TxManager.WithinTransaction
+func (r *OutboxRepository) Save(ctx context.Context, events ...board.Event) error {
+	query := "INSERT INTO outbox_events(board_id, event_type, payload, created_at) VALUES ($1, $2, $3, $4)"
+
+	for _, e := range events {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s event: %w", e.Type, err)
+		}
+
+		if _, err := conn(ctx, r.db).Exec(ctx, query, e.BoardID, string(e.Type), payload, e.OccurredAt); err != nil {
+			return fmt.Errorf("failed to save %s event: %w", e.Type, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) FetchPending(ctx context.Context, limit int) ([]outbox.Record, error) {
+	// SKIP LOCKED: несколько экземпляров релея не ждут друг друга и не берут одни и те же строки
+	query := `SELECT id, event_id::text, board_id, event_type, payload, created_at, attempts
+		FROM outbox_events
+		WHERE sent_at IS NULL AND next_attempt_at <= NOW()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]outbox.Record, 0, limit)
+
+	for rows.Next() {
+		var rec outbox.Record
+		if err := rows.Scan(&rec.ID, &rec.EventID, &rec.BoardID, &rec.EventType, &rec.Payload, &rec.CreatedAt, &rec.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+
+		records = append(records, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *OutboxRepository) HasEarlierPending(ctx context.Context, boardID, id int64) (bool, error) {
+	query := "SELECT EXISTS(SELECT 1 FROM outbox_events WHERE board_id = $1 AND id < $2 AND sent_at IS NULL)"
+
+	var exists bool
+	if err := conn(ctx, r.db).QueryRow(ctx, query, boardID, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check earlier outbox events: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, id int64) error {
+	query := "UPDATE outbox_events SET sent_at = NOW(), last_error = NULL WHERE id = $1"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark outbox event as sent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, reason string) error {
+	query := "UPDATE outbox_events SET attempts = $1, next_attempt_at = $2, last_error = $3 WHERE id = $4"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, attempts, nextAttemptAt, reason, id); err != nil {
+		return fmt.Errorf("failed to record outbox delivery failure: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"database/sql"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type TaskModel struct {
+	ID          int64          `db:"id"`
+	ColumnID    int64          `db:"column_id"`
+	Title       string         `db:"title"`
+	Description sql.NullString `db:"description"`
+	AssigneeID  sql.NullInt64  `db:"assignee_id"`
+	Position    int            `db:"position"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+func (m *TaskModel) toDomain() *board.Task {
+	return &board.Task{
+		ID:          m.ID,
+		ColumnID:    m.ColumnID,
+		Title:       m.Title,
+		Description: m.Description.String,
+		AssigneeID:  m.AssigneeID.Int64,
+		Position:    m.Position,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func taskFromDomain(t *board.Task) *TaskModel {
+	return &TaskModel{
+		ID:       t.ID,
+		ColumnID: t.ColumnID,
+		Title:    t.Title,
+		Description: sql.NullString{
+			String: t.Description,
+			Valid:  t.Description != "",
+		},
+		AssigneeID: sql.NullInt64{
+			Int64: t.AssigneeID,
+			Valid: t.AssigneeID != 0,
+		},
+		Position:  t.Position,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
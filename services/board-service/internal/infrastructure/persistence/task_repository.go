@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.TaskRepository = (*TaskRepository)(nil)
+
+const taskColumns = "id, column_id, title, description, assignee_id, position, created_at, updated_at"
+
+type TaskRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTaskRepository(db *pgxpool.Pool) *TaskRepository {
+	return &TaskRepository{db: db}
+}
+
+func (r *TaskRepository) Create(ctx context.Context, t *board.Task) error {
+	query := `INSERT INTO tasks(column_id, title, description, assignee_id, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`
+
+	model := taskFromDomain(t)
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, model.ColumnID, model.Title, model.Description, model.AssigneeID, model.Position, model.CreatedAt, model.UpdatedAt).
+		Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create task: %w", mapTaskError(err))
+	}
+
+	return nil
+}
+
+func (r *TaskRepository) GetByID(ctx context.Context, id int64) (*board.Task, error) {
+	query := "SELECT " + taskColumns + " FROM tasks WHERE id = $1"
+
+	model, err := scanTask(conn(ctx, r.db).QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *TaskRepository) ListByBoard(ctx context.Context, boardID int64) ([]*board.Task, error) {
+	// Одним запросом по всей доске, а не по запросу на колонку
+	query := `SELECT t.id, t.column_id, t.title, t.description, t.assignee_id, t.position, t.created_at, t.updated_at
+		FROM tasks t
+		JOIN board_columns c ON c.id = t.column_id
+		WHERE c.board_id = $1
+		ORDER BY c.position, t.position`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]*board.Task, 0)
+
+	for rows.Next() {
+		model, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		tasks = append(tasks, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) CountByColumn(ctx context.Context, columnID int64) (int, error) {
+	query := "SELECT COUNT(*) FROM tasks WHERE column_id = $1"
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, columnID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *TaskRepository) Update(ctx context.Context, t *board.Task) (*board.Task, error) {
+	query := "UPDATE tasks SET column_id = $1, title = $2, description = $3, assignee_id = $4, position = $5, updated_at = $6 WHERE id = $7 RETURNING " + taskColumns
+
+	model := taskFromDomain(t)
+
+	updated, err := scanTask(conn(ctx, r.db).QueryRow(ctx, query,
+		model.ColumnID, model.Title, model.Description, model.AssigneeID, model.Position, model.UpdatedAt, model.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, board.ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to update task: %w", mapTaskError(err))
+	}
+
+	return updated.toDomain(), nil
+}
+
+func (r *TaskRepository) ShiftPositions(ctx context.Context, columnID int64, from, to, delta int) error {
+	query := "UPDATE tasks SET position = position + $1 WHERE column_id = $2 AND position BETWEEN $3 AND $4"
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, delta, columnID, from, to); err != nil {
+		return fmt.Errorf("failed to shift task positions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TaskRepository) Delete(ctx context.Context, id int64) error {
+	query := "DELETE FROM tasks WHERE id = $1"
+
+	commandTag, err := conn(ctx, r.db).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return board.ErrTaskNotFound
+	}
+
+	return nil
+}
+
+func scanTask(row pgx.Row) (*TaskModel, error) {
+	var model TaskModel
+
+	err := row.Scan(
+		&model.ID,
+		&model.ColumnID,
+		&model.Title,
+		&model.Description,
+		&model.AssigneeID,
+		&model.Position,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
+
+// mapTaskError превращает нарушение внешнего ключа на исполнителя в доменную ошибку
+func mapTaskError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "tasks_assignee_id_fkey" {
+		return board.ErrAssigneeNotFound
+	}
+	return err
+}
@@ -0,0 +1,31 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.TransferRepository = (*TransferRepository)(nil)
+
+type TransferRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTransferRepository(db *pgxpool.Pool) *TransferRepository {
+	return &TransferRepository{db: db}
+}
+
+func (r *TransferRepository) Create(ctx context.Context, t *board.OwnershipTransfer) error {
+	query := "INSERT INTO board_transfers(board_id, from_user_id, to_user_id, transferred_by, transferred_at) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, t.BoardID, t.FromUserID, t.ToUserID, t.TransferredBy, t.TransferredAt).Scan(&t.ID)
+	if err != nil {
+		return fmt.Errorf("failed to save board transfer: %w", err)
+	}
+
+	return nil
+}
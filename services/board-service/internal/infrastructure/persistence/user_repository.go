@@ -0,0 +1,120 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"Taskify/services/board-service/internal/domain/board"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ board.UserRepository = (*UserRepository)(nil)
+
+// UserRepository читает таблицу users, которой владеет сервис авторизации
+type UserRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserRepository(db *pgxpool.Pool) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Exists(ctx context.Context, id int64) (bool, error) {
+	query := "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)"
+
+	var exists bool
+	if err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check user: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *UserRepository) GetEmail(ctx context.Context, id int64) (string, error) {
+	query := "SELECT email FROM users WHERE id = $1"
+
+	var email string
+	if err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(&email); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", board.ErrUserNotFound
+		}
+		return "", fmt.Errorf("failed to get user email: %w", err)
+	}
+
+	return email, nil
+}
+
+func (r *UserRepository) FindIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	ids := make(map[string]int64, len(usernames))
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	lowered := make([]string, 0, len(usernames))
+	for _, u := range usernames {
+		lowered = append(lowered, strings.ToLower(u))
+	}
+
+	query := "SELECT id, LOWER(username) FROM users WHERE LOWER(username) = ANY($1)"
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, lowered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id       int64
+			username string
+		)
+		if err := rows.Scan(&id, &username); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		ids[username] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *UserRepository) GetUsernames(ctx context.Context, ids []int64) (map[int64]string, error) {
+	usernames := make(map[int64]string, len(ids))
+	if len(ids) == 0 {
+		return usernames, nil
+	}
+
+	query := "SELECT id, username FROM users WHERE id = ANY($1)"
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usernames: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id       int64
+			username string
+		)
+		if err := rows.Scan(&id, &username); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		usernames[id] = username
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return usernames, nil
+}
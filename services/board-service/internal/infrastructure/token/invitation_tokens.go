@@ -0,0 +1,40 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+// invitationTokenBytes — энтропия токена приглашения, 256 бит
+const invitationTokenBytes = 32
+
+var _ usecase.InvitationTokens = (*InvitationTokens)(nil)
+
+// InvitationTokens выдает случайные токены приглашений.
+// В БД попадает только sha256, поэтому утечка таблицы не дает рабочих ссылок.
+type InvitationTokens struct{}
+
+func NewInvitationTokens() *InvitationTokens {
+	return &InvitationTokens{}
+}
+
+func (g *InvitationTokens) Generate() (string, string, error) {
+	buf := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	return raw, g.Hash(raw), nil
+}
+
+func (g *InvitationTokens) Hash(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,37 @@
+package token
+
+import (
+	"testing"
+)
+
+func TestInvitationTokens(t *testing.T) {
+	tokens := NewInvitationTokens()
+
+	first, hash, err := tokens.Generate()
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	second, _, err := tokens.Generate()
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{name: "hash matches token", ok: tokens.Hash(first) == hash},
+		{name: "hash is not the token", ok: hash != first},
+		{name: "256 bits of entropy", ok: len(first) == 43},
+		{name: "tokens are unique", ok: first != second},
+		{name: "another token has another hash", ok: tokens.Hash(second) != hash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.ok {
+				t.Errorf("token %q, hash %q", first, hash)
+			}
+		})
+	}
+}
@@ -28,40 +28,175 @@ type Handler struct {
 	// Зависимость: Хендлер знает только про UseCase
 	createBoardUC *usecase.CreateBoardUseCase
 	getBoardUC    *usecase.GetBoardUseCase
+	structureUC   usecase.BoardStructureReader
 	listBoardsUC  *usecase.ListBoardsUseCase
 	updateBoardUC *usecase.UpdateBoardUseCase
 	deleteBoardUC *usecase.DeleteBoardUseCase
+	moveBoardUC   *usecase.MoveBoardUseCase
+
+	archiveBoardUC   *usecase.ArchiveBoardUseCase
+	unarchiveBoardUC *usecase.UnarchiveBoardUseCase
+	restoreBoardUC   *usecase.RestoreBoardUseCase
+
+	cloneBoardUC       *usecase.CloneBoardUseCase
+	setBoardTemplateUC *usecase.SetBoardTemplateUseCase
+	listTemplatesUC    *usecase.ListTemplatesUseCase
+
+	importTrelloUC *usecase.ImportTrelloBoardUseCase
+	importBoardUC  *usecase.ImportBoardUseCase
+	exportBoardUC  *usecase.ExportBoardUseCase
+
+	createColumnUC *usecase.CreateColumnUseCase
+	renameColumnUC *usecase.RenameColumnUseCase
+	moveColumnUC   *usecase.MoveColumnUseCase
+	deleteColumnUC *usecase.DeleteColumnUseCase
+
+	createTaskUC *usecase.CreateTaskUseCase
+	getTaskUC    *usecase.GetTaskUseCase
+	updateTaskUC *usecase.UpdateTaskUseCase
+	deleteTaskUC *usecase.DeleteTaskUseCase
+	moveTaskUC   *usecase.MoveTaskUseCase
+
+	listMembersUC      *usecase.ListMembersUseCase
+	addMemberUC        *usecase.AddMemberUseCase
+	updateMemberRoleUC *usecase.UpdateMemberRoleUseCase
+	removeMemberUC     *usecase.RemoveMemberUseCase
+
+	createInvitationUC *usecase.CreateInvitationUseCase
+	listInvitationsUC  *usecase.ListInvitationsUseCase
+	revokeInvitationUC *usecase.RevokeInvitationUseCase
+	acceptInvitationUC *usecase.AcceptInvitationUseCase
+}
+
+// UseCases — все сценарии, которые обслуживает Handler.
+// Собраны в структуру, чтобы конструктор не разрастался с каждым новым RPC.
+type UseCases struct {
+	CreateBoard *usecase.CreateBoardUseCase
+	GetBoard    *usecase.GetBoardUseCase
+	Structure   usecase.BoardStructureReader
+	ListBoards  *usecase.ListBoardsUseCase
+	UpdateBoard *usecase.UpdateBoardUseCase
+	DeleteBoard *usecase.DeleteBoardUseCase
+	MoveBoard   *usecase.MoveBoardUseCase
+
+	ArchiveBoard   *usecase.ArchiveBoardUseCase
+	UnarchiveBoard *usecase.UnarchiveBoardUseCase
+	RestoreBoard   *usecase.RestoreBoardUseCase
+
+	CloneBoard       *usecase.CloneBoardUseCase
+	SetBoardTemplate *usecase.SetBoardTemplateUseCase
+	ListTemplates    *usecase.ListTemplatesUseCase
+
+	ImportTrello *usecase.ImportTrelloBoardUseCase
+	ImportBoard  *usecase.ImportBoardUseCase
+	ExportBoard  *usecase.ExportBoardUseCase
+
+	CreateColumn *usecase.CreateColumnUseCase
+	RenameColumn *usecase.RenameColumnUseCase
+	MoveColumn   *usecase.MoveColumnUseCase
+	DeleteColumn *usecase.DeleteColumnUseCase
+
+	CreateTask *usecase.CreateTaskUseCase
+	GetTask    *usecase.GetTaskUseCase
+	UpdateTask *usecase.UpdateTaskUseCase
+	DeleteTask *usecase.DeleteTaskUseCase
+	MoveTask   *usecase.MoveTaskUseCase
+
+	ListMembers      *usecase.ListMembersUseCase
+	AddMember        *usecase.AddMemberUseCase
+	UpdateMemberRole *usecase.UpdateMemberRoleUseCase
+	RemoveMember     *usecase.RemoveMemberUseCase
+
+	CreateInvitation *usecase.CreateInvitationUseCase
+	ListInvitations  *usecase.ListInvitationsUseCase
+	RevokeInvitation *usecase.RevokeInvitationUseCase
+	AcceptInvitation *usecase.AcceptInvitationUseCase
 }
 
 // Конструктор
-func NewHandler(createUC *usecase.CreateBoardUseCase, getUC *usecase.GetBoardUseCase, listBoardsUC *usecase.ListBoardsUseCase, updateBoardUC *usecase.UpdateBoardUseCase, deleteUC *usecase.DeleteBoardUseCase) *Handler {
+func NewHandler(uc UseCases) *Handler {
 	return &Handler{
-		createBoardUC: createUC,
-		getBoardUC:    getUC,
-		listBoardsUC:  listBoardsUC,
-		updateBoardUC: updateBoardUC,
-		deleteBoardUC: deleteUC,
+		createBoardUC: uc.CreateBoard,
+		getBoardUC:    uc.GetBoard,
+		structureUC:   uc.Structure,
+		listBoardsUC:  uc.ListBoards,
+		updateBoardUC: uc.UpdateBoard,
+		deleteBoardUC: uc.DeleteBoard,
+		moveBoardUC:   uc.MoveBoard,
+
+		archiveBoardUC:   uc.ArchiveBoard,
+		unarchiveBoardUC: uc.UnarchiveBoard,
+		restoreBoardUC:   uc.RestoreBoard,
+
+		cloneBoardUC:       uc.CloneBoard,
+		setBoardTemplateUC: uc.SetBoardTemplate,
+		listTemplatesUC:    uc.ListTemplates,
+
+		importTrelloUC: uc.ImportTrello,
+		importBoardUC:  uc.ImportBoard,
+		exportBoardUC:  uc.ExportBoard,
+
+		createColumnUC: uc.CreateColumn,
+		renameColumnUC: uc.RenameColumn,
+		moveColumnUC:   uc.MoveColumn,
+		deleteColumnUC: uc.DeleteColumn,
+
+		createTaskUC: uc.CreateTask,
+		getTaskUC:    uc.GetTask,
+		updateTaskUC: uc.UpdateTask,
+		deleteTaskUC: uc.DeleteTask,
+		moveTaskUC:   uc.MoveTask,
+
+		listMembersUC:      uc.ListMembers,
+		addMemberUC:        uc.AddMember,
+		updateMemberRoleUC: uc.UpdateMemberRole,
+		removeMemberUC:     uc.RemoveMember,
+
+		createInvitationUC: uc.CreateInvitation,
+		listInvitationsUC:  uc.ListInvitations,
+		revokeInvitationUC: uc.RevokeInvitation,
+		acceptInvitationUC: uc.AcceptInvitation,
 	}
 }
 
 func toProtoBoard(b *domain.Board) *pb.Board {
-	return &pb.Board{
+	board := &pb.Board{
 		Id:          b.ID,
 		Title:       b.Title,
 		Description: b.Description,
 		Owner:       b.Owner,
+		Version:     b.Version,
 		CreatedAt:   timestamppb.New(b.CreatedAt),
 		UpdatedAt:   timestamppb.New(b.UpdatedAt),
 	}
+
+	if b.ArchivedAt != nil {
+		board.ArchivedAt = timestamppb.New(*b.ArchivedAt)
+	}
+
+	if b.DeletedAt != nil {
+		board.DeletedAt = timestamppb.New(*b.DeletedAt)
+	}
+
+	board.IsTemplate = b.IsTemplate
+
+	return board
 }
 
 // CreateBoard — это метод, который вызовет gRPC сервер, когда придет запрос
 func (h *Handler) CreateBoard(ctx context.Context, req *pb.CreateBoardRequest) (*pb.CreateBoardResponse, error) {
+	// Владелец — всегда тот, кто создает доску
+	ownerID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// ШАГ 1: Преобразуем gRPC Request -> UseCase Command
 	command := usecase.CreateBoardCommand{
-		Title:       req.Title,
-		Description: req.Description,
-		OwnerID:     req.Owner,
+		Title:          req.Title,
+		Description:    req.Description,
+		OwnerID:        ownerID,
+		IdempotencyKey: idempotencyKey(ctx),
 	}
 
 	// ШАГ 2: Вызываем бизнес-логику
@@ -71,8 +206,11 @@ func (h *Handler) CreateBoard(ctx context.Context, req *pb.CreateBoardRequest) (
 	if err != nil {
 		// Пытаемся понять, какая именно ошибка произошла, чтобы вернуть верный HTTP/gRPC код
 		switch {
-		case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong), errors.Is(err, domain.ErrEmptyOwner):
+		case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong), errors.Is(err, domain.ErrEmptyOwner),
+			errors.Is(err, domain.ErrInvalidIdempotencyKey):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrIdempotencyKeyReused):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
 		}
@@ -99,6 +237,8 @@ func (h *Handler) GetBoard(ctx context.Context, id *pb.GetBoardRequest) (*pb.Get
 		switch {
 		case errors.Is(err, domain.ErrBoardNotFound):
 			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
 		}
@@ -109,23 +249,70 @@ func (h *Handler) GetBoard(ctx context.Context, id *pb.GetBoardRequest) (*pb.Get
 	}, nil
 }
 
-func (h *Handler) ListBoards(ctx context.Context, _ *emptypb.Empty) (*pb.ListBoardsResponse, error) {
+func (h *Handler) GetBoardStructure(ctx context.Context, req *pb.GetBoardStructureRequest) (*pb.GetBoardStructureResponse, error) {
+	structure, err := h.structureUC.Handle(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		}
+	}
+
+	columns := make([]*pb.ColumnStructure, 0, len(structure.Columns))
+	for _, c := range structure.Columns {
+		tasks := make([]*pb.Task, 0, len(c.Tasks))
+		for _, t := range c.Tasks {
+			tasks = append(tasks, toProtoTask(t))
+		}
+
+		columns = append(columns, &pb.ColumnStructure{
+			Column: toProtoColumn(&c.Column),
+			Tasks:  tasks,
+		})
+	}
+
+	return &pb.GetBoardStructureResponse{
+		Board:   toProtoBoard(&structure.Board),
+		Columns: columns,
+	}, nil
+}
+
+func (h *Handler) ListBoards(ctx context.Context, req *pb.ListBoardsRequest) (*pb.ListBoardsResponse, error) {
 	// 1. Получаем доменные сущности
-	domainBoards, err := h.listBoardsUC.Handle(ctx)
+	result, err := h.listBoardsUC.Handle(ctx, usecase.ListBoardsQuery{
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+		Search:    req.Search,
+		SortBy:    req.SortBy,
+		Order:     req.Order,
+		Archived:  req.Archived,
+	})
 	if err != nil {
-		// ErrBoardNotFound тут вряд ли будет (пустой список != ошибка), но оставим для примера
-		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidSort), errors.Is(err, domain.ErrInvalidPageSize), errors.Is(err, domain.ErrInvalidPageToken),
+			errors.Is(err, domain.ErrInvalidArchiveFilter):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		}
 	}
 
 	// 2. Конвертируем []*domain.Board -> []*pb.Board
-	protoBoards := make([]*pb.Board, 0, len(domainBoards))
-	for _, b := range domainBoards {
+	protoBoards := make([]*pb.Board, 0, len(result.Boards))
+	for _, b := range result.Boards {
 		protoBoards = append(protoBoards, toProtoBoard(b))
 	}
 
 	// 3. Возвращаем результат
 	return &pb.ListBoardsResponse{
-		Board: protoBoards, // Поле в proto называется 'repeated Board board = 1;'
+		Board:         protoBoards, // Поле в proto называется 'repeated Board board = 1;'
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -134,11 +321,24 @@ func (h *Handler) UpdateBoard(ctx context.Context, req *pb.UpdateBoardRequest) (
 		ID:          req.Id,
 		Title:       req.Title,       // Это уже *string благодаря 'optional' в proto
 		Description: req.Description, // Это тоже *string
+
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	updatedBoard, err := h.updateBoardUC.Handle(ctx, cmd)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrVersionConflict):
+			return nil, status.Error(codes.Aborted, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		}
 	}
 
 	return &pb.UpdateBoardResponse{
@@ -152,6 +352,8 @@ func (h *Handler) DeleteBoard(ctx context.Context, id *pb.DeleteBoardRequest) (*
 		switch {
 		case errors.Is(err, domain.ErrBoardNotFound):
 			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
 		}
@@ -159,3 +361,76 @@ func (h *Handler) DeleteBoard(ctx context.Context, id *pb.DeleteBoardRequest) (*
 
 	return &emptypb.Empty{}, nil
 }
+
+// MoveBoard передает доску другому владельцу
+func (h *Handler) MoveBoard(ctx context.Context, req *pb.MoveBoardRequest) (*pb.MoveBoardResponse, error) {
+	movedBy, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movedBoard, err := h.moveBoardUC.Handle(ctx, usecase.MoveBoardCommand{
+		ID:      req.Id,
+		Owner:   req.Owner,
+		MovedBy: movedBy,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrEmptyOwner), errors.Is(err, domain.ErrEmptyTransferActor):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+		}
+	}
+
+	return &pb.MoveBoardResponse{
+		Board: toProtoBoard(movedBoard),
+	}, nil
+}
+
+func (h *Handler) ArchiveBoard(ctx context.Context, req *pb.ArchiveBoardRequest) (*pb.ArchiveBoardResponse, error) {
+	archived, err := h.archiveBoardUC.Handle(ctx, req.Id)
+	if err != nil {
+		return nil, boardStateError(err)
+	}
+
+	return &pb.ArchiveBoardResponse{Board: toProtoBoard(archived)}, nil
+}
+
+func (h *Handler) UnarchiveBoard(ctx context.Context, req *pb.UnarchiveBoardRequest) (*pb.UnarchiveBoardResponse, error) {
+	unarchived, err := h.unarchiveBoardUC.Handle(ctx, req.Id)
+	if err != nil {
+		return nil, boardStateError(err)
+	}
+
+	return &pb.UnarchiveBoardResponse{Board: toProtoBoard(unarchived)}, nil
+}
+
+func (h *Handler) RestoreBoard(ctx context.Context, req *pb.RestoreBoardRequest) (*pb.RestoreBoardResponse, error) {
+	restored, err := h.restoreBoardUC.Handle(ctx, req.Id)
+	if err != nil {
+		return nil, boardStateError(err)
+	}
+
+	return &pb.RestoreBoardResponse{Board: toProtoBoard(restored)}, nil
+}
+
+// boardStateError — ошибки архивации и восстановления доски
+func boardStateError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrBoardAlreadyArchived), errors.Is(err, domain.ErrBoardNotArchived), errors.Is(err, domain.ErrBoardNotDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/boards/v1"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+func toProtoColumn(c *domain.Column) *pb.Column {
+	return &pb.Column{
+		Id:        c.ID,
+		BoardId:   c.BoardID,
+		Title:     c.Title,
+		Position:  int32(c.Position),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// columnError переводит доменные ошибки колонок в gRPC статусы
+func columnError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrColumnNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrColumnTitleRequired), errors.Is(err, domain.ErrColumnTitleTooLong), errors.Is(err, domain.ErrInvalidPosition):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) CreateColumn(ctx context.Context, req *pb.CreateColumnRequest) (*pb.CreateColumnResponse, error) {
+	column, err := h.createColumnUC.Handle(ctx, usecase.CreateColumnCommand{
+		BoardID: req.BoardId,
+		Title:   req.Title,
+	})
+	if err != nil {
+		return nil, columnError(err)
+	}
+
+	return &pb.CreateColumnResponse{Column: toProtoColumn(column)}, nil
+}
+
+func (h *Handler) RenameColumn(ctx context.Context, req *pb.RenameColumnRequest) (*pb.RenameColumnResponse, error) {
+	column, err := h.renameColumnUC.Handle(ctx, usecase.RenameColumnCommand{
+		BoardID:  req.BoardId,
+		ColumnID: req.Id,
+		Title:    req.Title,
+	})
+	if err != nil {
+		return nil, columnError(err)
+	}
+
+	return &pb.RenameColumnResponse{Column: toProtoColumn(column)}, nil
+}
+
+func (h *Handler) MoveColumn(ctx context.Context, req *pb.MoveColumnRequest) (*pb.MoveColumnResponse, error) {
+	column, err := h.moveColumnUC.Handle(ctx, usecase.MoveColumnCommand{
+		BoardID:  req.BoardId,
+		ColumnID: req.Id,
+		Position: int(req.Position),
+	})
+	if err != nil {
+		return nil, columnError(err)
+	}
+
+	return &pb.MoveColumnResponse{Column: toProtoColumn(column)}, nil
+}
+
+func (h *Handler) DeleteColumn(ctx context.Context, req *pb.DeleteColumnRequest) (*emptypb.Empty, error) {
+	err := h.deleteColumnUC.Handle(ctx, usecase.DeleteColumnCommand{
+		BoardID:  req.BoardId,
+		ColumnID: req.Id,
+	})
+	if err != nil {
+		return nil, columnError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,100 @@
+package grpc_handler
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "Taskify/proto/boards/v1"
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+// exportChunkSize — размер части выгрузки; с запасом меньше лимита сообщения gRPC в 4 МБ
+const exportChunkSize = 64 * 1024
+
+func (h *Handler) ExportBoard(req *pb.ExportBoardRequest, stream pb.BoardService_ExportBoardServer) error {
+	export, err := h.exportBoardUC.Handle(stream.Context(), usecase.ExportBoardQuery{
+		BoardID: req.Id,
+		Format:  req.Format,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrInvalidExportFormat):
+			return status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return status.Errorf(codes.Internal, "internal error: %v", err)
+		}
+	}
+
+	w := &chunkWriter{
+		stream: stream,
+		first: &pb.ExportBoardChunk{
+			ContentType: export.ContentType(),
+			FileName:    export.FileName(),
+		},
+		buf: make([]byte, 0, exportChunkSize),
+	}
+
+	if err := export.Write(w); err != nil {
+		return status.Errorf(codes.Internal, "failed to export board: %v", err)
+	}
+
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export board: %v", err)
+	}
+
+	return nil
+}
+
+// chunkWriter режет выгрузку на части по exportChunkSize и отправляет их в поток.
+// Первая часть несет тип содержимого и имя файла, даже если выгрузка пустая
+type chunkWriter struct {
+	stream pb.BoardService_ExportBoardServer
+	first  *pb.ExportBoardChunk
+	buf    []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := len(p)
+
+	for len(p) > 0 {
+		n := min(exportChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+
+		if len(w.buf) == exportChunkSize {
+			if err := w.send(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// Flush отправляет остаток буфера
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 && w.first == nil {
+		return nil
+	}
+
+	return w.send()
+}
+
+func (w *chunkWriter) send() error {
+	chunk := &pb.ExportBoardChunk{}
+	if w.first != nil {
+		chunk, w.first = w.first, nil
+	}
+
+	chunk.Data = append([]byte(nil), w.buf...)
+	w.buf = w.buf[:0]
+
+	return w.stream.Send(chunk)
+}
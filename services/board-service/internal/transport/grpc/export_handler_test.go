@@ -0,0 +1,114 @@
+package grpc_handler
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "Taskify/proto/boards/v1"
+)
+
+// memoryExportStream запоминает отправленные части выгрузки
+type memoryExportStream struct {
+	grpc.ServerStream
+	chunks []*pb.ExportBoardChunk
+	err    error
+}
+
+func (s *memoryExportStream) Send(chunk *pb.ExportBoardChunk) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestChunkWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		// writes — размеры последовательных вызовов Write
+		writes []int
+		sizes  []int
+	}{
+		{name: "empty export", writes: nil, sizes: []int{0}},
+		{name: "small export", writes: []int{10}, sizes: []int{10}},
+		{name: "exactly one chunk", writes: []int{exportChunkSize}, sizes: []int{exportChunkSize}},
+		{name: "one byte over", writes: []int{exportChunkSize + 1}, sizes: []int{exportChunkSize, 1}},
+		{name: "many small writes", writes: []int{exportChunkSize - 1, 2, exportChunkSize}, sizes: []int{exportChunkSize, exportChunkSize, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &memoryExportStream{}
+			w := &chunkWriter{
+				stream: stream,
+				first:  &pb.ExportBoardChunk{ContentType: "text/csv", FileName: "board.csv"},
+				buf:    make([]byte, 0, exportChunkSize),
+			}
+
+			var want []byte
+			for i, size := range tt.writes {
+				p := bytes.Repeat([]byte{byte('a' + i)}, size)
+				want = append(want, p...)
+				if n, err := w.Write(p); n != size || err != nil {
+					t.Fatalf("Write = %d, %v, want %d", n, err, size)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush: %v", err)
+			}
+
+			if len(stream.chunks) != len(tt.sizes) {
+				t.Fatalf("chunks = %d, want %d", len(stream.chunks), len(tt.sizes))
+			}
+			var got []byte
+			for i, chunk := range stream.chunks {
+				if len(chunk.Data) != tt.sizes[i] {
+					t.Errorf("chunk %d size = %d, want %d", i, len(chunk.Data), tt.sizes[i])
+				}
+				// Тип содержимого и имя файла — только в первой части
+				if (chunk.ContentType != "") != (i == 0) || (chunk.FileName != "") != (i == 0) {
+					t.Errorf("chunk %d metadata = %q, %q", i, chunk.ContentType, chunk.FileName)
+				}
+				got = append(got, chunk.Data...)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("reassembled %d bytes differ from %d written", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestChunkWriterSendError(t *testing.T) {
+	sendErr := errors.New("stream closed")
+
+	tests := []struct {
+		name string
+		// write — размер записи до Flush
+		write int
+	}{
+		{name: "full chunk on write", write: exportChunkSize},
+		{name: "rest on flush", write: 10},
+		{name: "empty export on flush", write: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &chunkWriter{
+				stream: &memoryExportStream{err: sendErr},
+				first:  &pb.ExportBoardChunk{},
+				buf:    make([]byte, 0, exportChunkSize),
+			}
+
+			_, err := w.Write(make([]byte, tt.write))
+			if err == nil {
+				err = w.Flush()
+			}
+			if !errors.Is(err, sendErr) {
+				t.Errorf("err = %v, want %v", err, sendErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package grpc_handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyMetadataKey — ключ gRPC metadata с ключом идемпотентности клиента.
+// API Gateway переносит сюда HTTP заголовок Idempotency-Key.
+const IdempotencyMetadataKey = "idempotency-key"
+
+// idempotencyKey возвращает ключ идемпотентности запроса или пустую строку
+func idempotencyKey(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, IdempotencyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,29 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "no metadata", ctx: context.Background(), want: ""},
+		{name: "without key", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "1")), want: ""},
+		{name: "with key", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyMetadataKey, "key-1")), want: "key-1"},
+		{name: "first of several", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyMetadataKey, "key-1", IdempotencyMetadataKey, "key-2")), want: "key-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := idempotencyKey(tt.ctx); got != tt.want {
+				t.Errorf("idempotencyKey = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package grpc_handler
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Taskify/services/board-service/internal/identity"
+)
+
+// IdentityInterceptor переносит id пользователя из metadata в context.
+// Запрос без metadata проходит дальше анонимным — какие методы требуют
+// пользователя, решают сами хендлеры через callerID.
+func IdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := withIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// IdentityStreamInterceptor — то же, что IdentityInterceptor, для потоковых RPC
+func IdentityStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withIdentity(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// identityStream подменяет контекст потока на контекст с id пользователя
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+func withIdentity(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	values := md.Get(identity.MetadataKey)
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	userID, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, status.Error(codes.Unauthenticated, "invalid user id in metadata")
+	}
+
+	return identity.WithUserID(ctx, userID), nil
+}
+
+// callerID возвращает id пользователя, от имени которого пришел запрос
+func callerID(ctx context.Context) (int64, error) {
+	userID, ok := identity.UserID(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	return userID, nil
+}
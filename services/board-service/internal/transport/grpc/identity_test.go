@@ -0,0 +1,60 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"Taskify/services/board-service/internal/identity"
+)
+
+func TestIdentityInterceptor(t *testing.T) {
+	incoming := func(pairs ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		// code — ответ интерсептора, caller — ответ callerID внутри хендлера
+		code   codes.Code
+		caller codes.Code
+		userID int64
+	}{
+		{name: "user from gateway", ctx: incoming(identity.MetadataKey, "42"), code: codes.OK, caller: codes.OK, userID: 42},
+		{name: "no metadata", ctx: context.Background(), code: codes.OK, caller: codes.Unauthenticated},
+		{name: "metadata without user", ctx: incoming("other", "1"), code: codes.OK, caller: codes.Unauthenticated},
+		{name: "malformed user id", ctx: incoming(identity.MetadataKey, "bob"), code: codes.Unauthenticated},
+		{name: "zero user id", ctx: incoming(identity.MetadataKey, "0"), code: codes.Unauthenticated},
+		{name: "negative user id", ctx: incoming(identity.MetadataKey, "-1"), code: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			_, err := IdentityInterceptor()(tt.ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				called = true
+
+				userID, err := callerID(ctx)
+				if code := status.Code(err); code != tt.caller {
+					t.Errorf("callerID code = %v, want %v", code, tt.caller)
+				}
+				if userID != tt.userID {
+					t.Errorf("callerID = %d, want %d", userID, tt.userID)
+				}
+				return nil, nil
+			})
+
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %v, want %v", code, tt.code)
+			}
+			if called != (tt.code == codes.OK) {
+				t.Errorf("handler called = %v", called)
+			}
+		})
+	}
+}
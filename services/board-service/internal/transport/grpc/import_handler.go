@@ -0,0 +1,78 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "Taskify/proto/boards/v1"
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+func (h *Handler) ImportTrelloBoard(ctx context.Context, req *pb.ImportTrelloBoardRequest) (*pb.ImportBoardResponse, error) {
+	ownerID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.importTrelloUC.Handle(ctx, usecase.ImportTrelloBoardCommand{
+		Export:  req.Export,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	return toProtoImportReport(report), nil
+}
+
+func (h *Handler) ImportBoard(ctx context.Context, req *pb.ImportBoardRequest) (*pb.ImportBoardResponse, error) {
+	ownerID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.importBoardUC.Handle(ctx, usecase.ImportBoardCommand{
+		Document: req.Document,
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	return toProtoImportReport(report), nil
+}
+
+// importError переводит ошибки импорта в gRPC статусы
+func importError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidImport), errors.Is(err, domain.ErrUnsupportedDocumentVersion),
+		errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func toProtoImportReport(report *usecase.ImportReport) *pb.ImportBoardResponse {
+	issues := make([]*pb.ImportIssue, 0, len(report.Issues))
+	for _, i := range report.Issues {
+		issues = append(issues, &pb.ImportIssue{
+			Kind:     i.Kind,
+			SourceId: i.SourceID,
+			Name:     i.Name,
+			Reason:   i.Reason,
+		})
+	}
+
+	return &pb.ImportBoardResponse{
+		Board:       toProtoBoard(report.Board),
+		Columns:     int32(report.Columns),
+		Tasks:       int32(report.Tasks),
+		Invitations: int32(report.Invitations),
+		Issues:      issues,
+	}
+}
@@ -0,0 +1,95 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/boards/v1"
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+func toProtoInvitation(i *domain.Invitation) *pb.BoardInvitation {
+	return &pb.BoardInvitation{
+		Id:        i.ID,
+		BoardId:   i.BoardID,
+		Email:     i.Email,
+		Role:      string(i.Role),
+		InvitedBy: i.InvitedBy,
+		ExpiresAt: timestamppb.New(i.ExpiresAt),
+		CreatedAt: timestamppb.New(i.CreatedAt),
+	}
+}
+
+// invitationError переводит доменные ошибки приглашений в gRPC статусы
+func invitationError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrInvitationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrInvitationEmailMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidEmail), errors.Is(err, domain.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvitationExpired), errors.Is(err, domain.ErrInvitationRevoked),
+		errors.Is(err, domain.ErrInvitationAlreadyUsed), errors.Is(err, domain.ErrOwnerRoleImmutable),
+		errors.Is(err, domain.ErrBoardArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) CreateBoardInvitation(ctx context.Context, req *pb.CreateBoardInvitationRequest) (*pb.CreateBoardInvitationResponse, error) {
+	invitation, err := h.createInvitationUC.Handle(ctx, usecase.CreateInvitationCommand{
+		BoardID: req.BoardId,
+		Email:   req.Email,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return nil, invitationError(err)
+	}
+
+	return &pb.CreateBoardInvitationResponse{Invitation: toProtoInvitation(invitation)}, nil
+}
+
+func (h *Handler) ListBoardInvitations(ctx context.Context, req *pb.ListBoardInvitationsRequest) (*pb.ListBoardInvitationsResponse, error) {
+	invitations, err := h.listInvitationsUC.Handle(ctx, req.BoardId)
+	if err != nil {
+		return nil, invitationError(err)
+	}
+
+	protoInvitations := make([]*pb.BoardInvitation, 0, len(invitations))
+	for _, i := range invitations {
+		protoInvitations = append(protoInvitations, toProtoInvitation(i))
+	}
+
+	return &pb.ListBoardInvitationsResponse{Invitations: protoInvitations}, nil
+}
+
+func (h *Handler) RevokeBoardInvitation(ctx context.Context, req *pb.RevokeBoardInvitationRequest) (*emptypb.Empty, error) {
+	err := h.revokeInvitationUC.Handle(ctx, usecase.RevokeInvitationCommand{
+		BoardID:      req.BoardId,
+		InvitationID: req.Id,
+	})
+	if err != nil {
+		return nil, invitationError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) AcceptBoardInvitation(ctx context.Context, req *pb.AcceptBoardInvitationRequest) (*pb.AcceptBoardInvitationResponse, error) {
+	member, err := h.acceptInvitationUC.Handle(ctx, req.Token)
+	if err != nil {
+		return nil, invitationError(err)
+	}
+
+	return &pb.AcceptBoardInvitationResponse{Member: toProtoMember(member)}, nil
+}
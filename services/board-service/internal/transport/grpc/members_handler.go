@@ -0,0 +1,95 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/boards/v1"
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+func toProtoMember(m *domain.Member) *pb.BoardMember {
+	return &pb.BoardMember{
+		BoardId:   m.BoardID,
+		UserId:    m.UserID,
+		Role:      string(m.Role),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// memberError переводит доменные ошибки участников в gRPC статусы
+func memberError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidRole), errors.Is(err, domain.ErrEmptyMember), errors.Is(err, domain.ErrUserNotFound):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrOwnerRoleImmutable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) ListBoardMembers(ctx context.Context, req *pb.ListBoardMembersRequest) (*pb.ListBoardMembersResponse, error) {
+	members, err := h.listMembersUC.Handle(ctx, req.BoardId)
+	if err != nil {
+		return nil, memberError(err)
+	}
+
+	protoMembers := make([]*pb.BoardMember, 0, len(members))
+	for _, m := range members {
+		protoMembers = append(protoMembers, toProtoMember(m))
+	}
+
+	return &pb.ListBoardMembersResponse{Members: protoMembers}, nil
+}
+
+func (h *Handler) AddBoardMember(ctx context.Context, req *pb.AddBoardMemberRequest) (*pb.AddBoardMemberResponse, error) {
+	member, err := h.addMemberUC.Handle(ctx, usecase.AddMemberCommand{
+		BoardID: req.BoardId,
+		UserID:  req.UserId,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return nil, memberError(err)
+	}
+
+	return &pb.AddBoardMemberResponse{Member: toProtoMember(member)}, nil
+}
+
+func (h *Handler) UpdateBoardMemberRole(ctx context.Context, req *pb.UpdateBoardMemberRoleRequest) (*pb.UpdateBoardMemberRoleResponse, error) {
+	member, err := h.updateMemberRoleUC.Handle(ctx, usecase.UpdateMemberRoleCommand{
+		BoardID: req.BoardId,
+		UserID:  req.UserId,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return nil, memberError(err)
+	}
+
+	return &pb.UpdateBoardMemberRoleResponse{Member: toProtoMember(member)}, nil
+}
+
+func (h *Handler) RemoveBoardMember(ctx context.Context, req *pb.RemoveBoardMemberRequest) (*emptypb.Empty, error) {
+	err := h.removeMemberUC.Handle(ctx, usecase.RemoveMemberCommand{
+		BoardID: req.BoardId,
+		UserID:  req.UserId,
+	})
+	if err != nil {
+		return nil, memberError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
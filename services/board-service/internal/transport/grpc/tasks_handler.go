@@ -0,0 +1,112 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/boards/v1"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+func toProtoTask(t *domain.Task) *pb.Task {
+	return &pb.Task{
+		Id:          t.ID,
+		ColumnId:    t.ColumnID,
+		Title:       t.Title,
+		Description: t.Description,
+		AssigneeId:  t.AssigneeID,
+		Position:    int32(t.Position),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
+
+// taskError переводит доменные ошибки задач в gRPC статусы
+func taskError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrColumnNotFound), errors.Is(err, domain.ErrTaskNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrTaskTitleRequired), errors.Is(err, domain.ErrTaskTitleTooLong),
+		errors.Is(err, domain.ErrAssigneeNotFound), errors.Is(err, domain.ErrInvalidPosition):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
+	task, err := h.createTaskUC.Handle(ctx, usecase.CreateTaskCommand{
+		BoardID:     req.BoardId,
+		ColumnID:    req.ColumnId,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeID:  req.AssigneeId,
+	})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &pb.CreateTaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *Handler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	task, err := h.getTaskUC.Handle(ctx, usecase.GetTaskQuery{
+		BoardID: req.BoardId,
+		TaskID:  req.Id,
+	})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &pb.GetTaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *Handler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	task, err := h.updateTaskUC.Handle(ctx, usecase.UpdateTaskCommand{
+		BoardID:     req.BoardId,
+		TaskID:      req.Id,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeID:  req.AssigneeId,
+	})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &pb.UpdateTaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *Handler) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*emptypb.Empty, error) {
+	err := h.deleteTaskUC.Handle(ctx, usecase.DeleteTaskCommand{
+		BoardID: req.BoardId,
+		TaskID:  req.Id,
+	})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) MoveTask(ctx context.Context, req *pb.MoveTaskRequest) (*pb.MoveTaskResponse, error) {
+	task, err := h.moveTaskUC.Handle(ctx, usecase.MoveTaskCommand{
+		BoardID:  req.BoardId,
+		TaskID:   req.Id,
+		ColumnID: req.ColumnId,
+		Position: int(req.Position),
+	})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &pb.MoveTaskResponse{Task: toProtoTask(task)}, nil
+}
@@ -0,0 +1,74 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "Taskify/proto/boards/v1"
+	domain "Taskify/services/board-service/internal/domain/board"
+	usecase "Taskify/services/board-service/internal/usecase/board"
+)
+
+// templateError переводит ошибки клонирования и шаблонов в gRPC статусы
+func templateError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *Handler) CloneBoard(ctx context.Context, req *pb.CloneBoardRequest) (*pb.CloneBoardResponse, error) {
+	// Копия принадлежит тому, кто клонирует
+	ownerID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := h.cloneBoardUC.Handle(ctx, usecase.CloneBoardCommand{
+		SourceID: req.Id,
+		Title:    req.Title,
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return &pb.CloneBoardResponse{Board: toProtoBoard(clone)}, nil
+}
+
+func (h *Handler) SetBoardTemplate(ctx context.Context, req *pb.SetBoardTemplateRequest) (*pb.SetBoardTemplateResponse, error) {
+	b, err := h.setBoardTemplateUC.Handle(ctx, usecase.SetBoardTemplateCommand{
+		BoardID:    req.Id,
+		IsTemplate: req.IsTemplate,
+	})
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return &pb.SetBoardTemplateResponse{Board: toProtoBoard(b)}, nil
+}
+
+func (h *Handler) ListBoardTemplates(ctx context.Context, _ *pb.ListBoardTemplatesRequest) (*pb.ListBoardTemplatesResponse, error) {
+	templates, err := h.listTemplatesUC.Handle(ctx)
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	protoBoards := make([]*pb.Board, 0, len(templates))
+	for _, t := range templates {
+		protoBoards = append(protoBoards, toProtoBoard(t))
+	}
+
+	return &pb.ListBoardTemplatesResponse{Boards: protoBoards}, nil
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/jwtauth"
+	"Taskify/services/board-service/internal/identity"
+)
+
+// userIDKey — ключ Fiber locals, под которым лежит id аутентифицированного пользователя
+const userIDKey = "userID"
+
+// JWTAuth пропускает запрос, только если в нем действующий access токен Auth Service.
+// Id пользователя кладется в Fiber locals и в UserContext запроса.
+func JWTAuth(secret, issuer string) fiber.Handler {
+	return jwtauth.Middleware(secret, issuer, func(c *fiber.Ctx, userID int64) {
+		c.Locals(userIDKey, userID)
+		c.SetUserContext(identity.WithUserID(c.UserContext(), userID))
+	})
+}
+
+// UserID возвращает id пользователя, положенный JWTAuth
+func UserID(c *fiber.Ctx) (int64, bool) {
+	userID, ok := c.Locals(userIDKey).(int64)
+	return userID, ok
+}
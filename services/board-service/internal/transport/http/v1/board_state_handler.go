@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/etag"
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+// BoardStateHandler — архивация доски и восстановление после удаления
+type BoardStateHandler struct {
+	archiveUC   *board.ArchiveBoardUseCase
+	unarchiveUC *board.UnarchiveBoardUseCase
+	restoreUC   *board.RestoreBoardUseCase
+}
+
+func NewBoardStateHandler(api fiber.Router, archiveUC *board.ArchiveBoardUseCase, unarchiveUC *board.UnarchiveBoardUseCase, restoreUC *board.RestoreBoardUseCase) {
+	handler := &BoardStateHandler{
+		archiveUC:   archiveUC,
+		unarchiveUC: unarchiveUC,
+		restoreUC:   restoreUC,
+	}
+
+	boards := api.Group("/boards/:id")
+	boards.Post("/archive", handler.archiveBoard)
+	boards.Post("/unarchive", handler.unarchiveBoard)
+	boards.Post("/restore", handler.restoreBoard)
+}
+
+// boardStateErrorResponse переводит ошибки архивации и восстановления в HTTP статусы
+func boardStateErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrBoardAlreadyArchived), errors.Is(err, domain.ErrBoardNotArchived), errors.Is(err, domain.ErrBoardNotDeleted):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrVersionConflict):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// @Summary Archive a board
+// @Description Hide a board from the default board list; it stays readable by ID
+// @Tags boards
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /boards/{id}/archive [post]
+func (h *BoardStateHandler) archiveBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	b, err := h.archiveUC.Handle(c.UserContext(), int64(id))
+	if err != nil {
+		return boardStateErrorResponse(c, err)
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.JSON(b)
+}
+
+// @Summary Unarchive a board
+// @Description Return an archived board to the default board list
+// @Tags boards
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /boards/{id}/unarchive [post]
+func (h *BoardStateHandler) unarchiveBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	b, err := h.unarchiveUC.Handle(c.UserContext(), int64(id))
+	if err != nil {
+		return boardStateErrorResponse(c, err)
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.JSON(b)
+}
+
+// @Summary Restore a deleted board
+// @Description Undo board deletion before the board is purged
+// @Tags boards
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /boards/{id}/restore [post]
+func (h *BoardStateHandler) restoreBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	b, err := h.restoreUC.Handle(c.UserContext(), int64(id))
+	if err != nil {
+		return boardStateErrorResponse(c, err)
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.JSON(b)
+}
@@ -5,47 +5,66 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 
+	"Taskify/pkg/etag"
 	// Импорт твоих юзкейсов и домена
 	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/transport/http/middleware"
 	"Taskify/services/board-service/internal/usecase/board"
 )
 
 type BoardHandler struct {
-	createUC *board.CreateBoardUseCase
-	getUC    *board.GetBoardUseCase
-	listUC   *board.ListBoardsUseCase
-	updateUC *board.UpdateBoardUseCase
-	deleteUC *board.DeleteBoardUseCase
+	createUC    *board.CreateBoardUseCase
+	getUC       *board.GetBoardUseCase
+	structureUC board.BoardStructureReader
+	listUC      *board.ListBoardsUseCase
+	updateUC    *board.UpdateBoardUseCase
+	deleteUC    *board.DeleteBoardUseCase
+	moveUC      *board.MoveBoardUseCase
 }
 
-func NewBoardHandler(api fiber.Router, createUC *board.CreateBoardUseCase, getUC *board.GetBoardUseCase, listUC *board.ListBoardsUseCase, updateUC *board.UpdateBoardUseCase, deleteUC *board.DeleteBoardUseCase) {
+func NewBoardHandler(api fiber.Router, createUC *board.CreateBoardUseCase, getUC *board.GetBoardUseCase, structureUC board.BoardStructureReader, listUC *board.ListBoardsUseCase, updateUC *board.UpdateBoardUseCase, deleteUC *board.DeleteBoardUseCase, moveUC *board.MoveBoardUseCase) {
 	handler := &BoardHandler{
-		createUC: createUC,
-		getUC:    getUC,
-		listUC:   listUC,
-		updateUC: updateUC,
-		deleteUC: deleteUC,
+		createUC:    createUC,
+		getUC:       getUC,
+		structureUC: structureUC,
+		listUC:      listUC,
+		updateUC:    updateUC,
+		deleteUC:    deleteUC,
+		moveUC:      moveUC,
 	}
 
 	// Регистрируем маршруты
 	boards := api.Group("/boards")
 	boards.Post("/", handler.createBoard)
 	boards.Get("/:id", handler.getBoard)
+	boards.Get("/:id/structure", handler.getBoardStructure)
 	boards.Get("/", handler.listBoards)
 	boards.Patch("/:id", handler.updateBoard)
 	boards.Delete("/:id", handler.deleteBoard)
+	boards.Post("/:id/move", handler.moveBoard)
 }
 
+// idempotencyKeyHeader — заголовок, по которому повтор создания вернет исходный результат
+const idempotencyKeyHeader = "Idempotency-Key"
+
 // @Summary Create a new board
 // @Description Create a new board with title and description
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Param request body CreateBoardRequest true "Board creation info"
+// @Param Idempotency-Key header string false "Retried request with the same key returns the original board"
 // @Success 201 {object} board.Board
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /boards [post]
 func (h *BoardHandler) createBoard(c *fiber.Ctx) error {
+	ownerID, ok := middleware.UserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
 	var req CreateBoardRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
@@ -53,20 +72,26 @@ func (h *BoardHandler) createBoard(c *fiber.Ctx) error {
 
 	// Вызываем ТОТ ЖЕ usecase, что и gRPC!
 	cmd := board.CreateBoardCommand{
-		Title:       req.Title,
-		Description: req.Description,
-		OwnerID:     req.Owner,
+		Title:          req.Title,
+		Description:    req.Description,
+		OwnerID:        ownerID,
+		IdempotencyKey: c.Get(idempotencyKeyHeader),
 	}
 
-	b, err := h.createUC.Handle(c.Context(), cmd)
+	b, err := h.createUC.Handle(c.UserContext(), cmd)
 	if err != nil {
 		// Маппинг ошибок (можно вынести в middleware)
-		if errors.Is(err, domain.ErrTitleRequired) {
+		switch {
+		case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong), errors.Is(err, domain.ErrInvalidIdempotencyKey):
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrIdempotencyKeyReused):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	etag.SetVersion(c, b.Version)
+
 	return c.Status(fiber.StatusCreated).JSON(b)
 }
 
@@ -77,7 +102,9 @@ func (h *BoardHandler) createBoard(c *fiber.Ctx) error {
 // @Produce json
 // @Param id path int true "Board ID"
 // @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} ErrBoardNotFoundResponse
 // @Failure 500 {object} map[string]string
 // @Router /boards/{id} [get]
@@ -87,37 +114,98 @@ func (h *BoardHandler) getBoard(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
 	}
 
-	b, err := h.getUC.Handle(c.Context(), int64(id))
+	b, err := h.getUC.Handle(c.UserContext(), int64(id))
 	if err != nil {
-		if errors.Is(err, domain.ErrBoardNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "board not found"})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	etag.SetVersion(c, b.Version)
+
 	return c.JSON(b)
 }
 
-// @Summary List all boards
-// @Description Get a list of all boards
+// @Summary Get a board structure
+// @Description Get a board with all its columns and their tasks, ordered by position
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} board.Structure
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} ErrBoardNotFoundResponse
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/structure [get]
+func (h *BoardHandler) getBoardStructure(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	structure, err := h.structureUC.Handle(c.UserContext(), int64(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "board not found"})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	return c.JSON(structure)
+}
+
+// @Summary List boards
+// @Description Get a page of boards the caller owns or is a member of
 // @Tags boards
 // @Accept json
 // @Produce json
-// @Success 200 {array} board.Board
+// @Param pageSize query int false "Page size, 20 by default, at most 100"
+// @Param pageToken query string false "nextPageToken from the previous page"
+// @Param search query string false "Case-insensitive title substring"
+// @Param sortBy query string false "created_at (default) or updated_at"
+// @Param order query string false "desc (default) or asc"
+// @Param archived query string false "active (default), archived, all or deleted (boards you own that can still be restored)"
+// @Success 200 {object} BoardListResponse
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /boards [get]
 func (h *BoardHandler) listBoards(c *fiber.Ctx) error {
 	// 1. Вызываем UseCase
-	boards, err := h.listUC.Handle(c.Context())
+	result, err := h.listUC.Handle(c.UserContext(), board.ListBoardsQuery{
+		PageSize:  c.QueryInt("pageSize"),
+		PageToken: c.Query("pageToken"),
+		Search:    c.Query("search"),
+		SortBy:    c.Query("sortBy"),
+		Order:     c.Query("order"),
+		Archived:  c.Query("archived"),
+	})
 	if err != nil {
-		// Здесь ErrBoardNotFound не ожидается (пустой список - это ок),
-		// поэтому сразу 500, если что-то упало в базе
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		switch {
+		case errors.Is(err, domain.ErrInvalidSort), errors.Is(err, domain.ErrInvalidPageSize), errors.Is(err, domain.ErrInvalidPageToken),
+			errors.Is(err, domain.ErrInvalidArchiveFilter):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
 	}
 
 	// 2. Отдаем JSON (Fiber сам сделает маршалинг)
-	// Если boards == nil, вернется null. Если хочешь [], инициализируй слайс в репо или юзкейсе.
-	return c.JSON(boards)
+	return c.JSON(BoardListResponse{
+		Boards:        result.Boards,
+		NextPageToken: result.NextPageToken,
+	})
 }
 
 // @Summary Update a  board
@@ -126,10 +214,14 @@ func (h *BoardHandler) listBoards(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Board ID"
+// @Param If-Match header string false "ETag of the board version being edited"
 // @Param request body UpdateBoardRequest true "Board update info"
 // @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} ErrBoardNotFoundResponse
+// @Failure 412 {object} map[string]string
 // @Router /boards/{id} [patch]
 func (h *BoardHandler) updateBoard(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -142,30 +234,49 @@ func (h *BoardHandler) updateBoard(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
 	}
 
+	expectedVersion, err := etag.ExpectedVersion(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	cmd := board.UpdateBoardCommand{
 		ID:          int64(id),
 		Title:       req.Title,
 		Description: req.Description,
+
+		ExpectedVersion: expectedVersion,
 	}
 
-	b, err := h.updateUC.Handle(c.Context(), cmd)
+	b, err := h.updateUC.Handle(c.UserContext(), cmd)
 	if err != nil {
-		if errors.Is(err, domain.ErrBoardNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrVersionConflict):
+			return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 		}
 	}
 
+	etag.SetVersion(c, b.Version)
+
 	return c.JSON(b)
 }
 
 // @Summary Delete a board
-// @Description Delete a board by ID
+// @Description Delete a board by ID. The board can be restored until it is purged
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Param id path int true "Board ID"
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /boards/{id} [delete]
@@ -175,13 +286,70 @@ func (h *BoardHandler) deleteBoard(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
 	}
 
-	err = h.deleteUC.Handle(c.Context(), int64(id))
+	err = h.deleteUC.Handle(c.UserContext(), int64(id))
 	if err != nil {
-		if errors.Is(err, domain.ErrBoardNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "board not found"})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// @Summary Move a board
+// @Description Transfer a board to another owner
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body MoveBoardRequest true "New owner"
+// @Success 200 {object} board.Board
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} ErrBoardNotFoundResponse
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/move [post]
+func (h *BoardHandler) moveBoard(c *fiber.Ctx) error {
+	movedBy, ok := middleware.UserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+
+	b, err := h.moveUC.Handle(c.UserContext(), board.MoveBoardCommand{
+		ID:      int64(id),
+		Owner:   req.Owner,
+		MovedBy: movedBy,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "board not found"})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrEmptyOwner), errors.Is(err, domain.ErrEmptyTransferActor):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.JSON(b)
+}
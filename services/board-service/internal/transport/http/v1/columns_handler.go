@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type ColumnHandler struct {
+	createUC *board.CreateColumnUseCase
+	renameUC *board.RenameColumnUseCase
+	moveUC   *board.MoveColumnUseCase
+	deleteUC *board.DeleteColumnUseCase
+}
+
+func NewColumnHandler(api fiber.Router, createUC *board.CreateColumnUseCase, renameUC *board.RenameColumnUseCase, moveUC *board.MoveColumnUseCase, deleteUC *board.DeleteColumnUseCase) {
+	handler := &ColumnHandler{
+		createUC: createUC,
+		renameUC: renameUC,
+		moveUC:   moveUC,
+		deleteUC: deleteUC,
+	}
+
+	columns := api.Group("/boards/:id/columns")
+	columns.Post("/", handler.createColumn)
+	columns.Patch("/:columnId", handler.renameColumn)
+	columns.Post("/:columnId/move", handler.moveColumn)
+	columns.Delete("/:columnId", handler.deleteColumn)
+}
+
+// columnErrorResponse переводит доменные ошибки колонок в HTTP статусы
+func columnErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrColumnNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrColumnTitleRequired), errors.Is(err, domain.ErrColumnTitleTooLong), errors.Is(err, domain.ErrInvalidPosition):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// parseColumnParams читает id доски и колонки из пути
+func parseColumnParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	columnID, err := c.ParamsInt("columnId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(columnID), nil
+}
+
+// @Summary Create a column
+// @Description Append a new column to the end of the board
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body CreateColumnRequest true "Column creation info"
+// @Success 201 {object} board.Column
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/columns [post]
+func (h *ColumnHandler) createColumn(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	column, err := h.createUC.Handle(c.UserContext(), board.CreateColumnCommand{
+		BoardID: int64(boardID),
+		Title:   req.Title,
+	})
+	if err != nil {
+		return columnErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(column)
+}
+
+// @Summary Rename a column
+// @Description Change the title of a column
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body RenameColumnRequest true "New column title"
+// @Success 200 {object} board.Column
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/columns/{columnId} [patch]
+func (h *ColumnHandler) renameColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req RenameColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	column, err := h.renameUC.Handle(c.UserContext(), board.RenameColumnCommand{
+		BoardID:  boardID,
+		ColumnID: columnID,
+		Title:    req.Title,
+	})
+	if err != nil {
+		return columnErrorResponse(c, err)
+	}
+
+	return c.JSON(column)
+}
+
+// @Summary Move a column
+// @Description Move a column to another position on the board, shifting its neighbours
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body MoveColumnRequest true "Target position (zero-based)"
+// @Success 200 {object} board.Column
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/columns/{columnId}/move [post]
+func (h *ColumnHandler) moveColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveColumnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	column, err := h.moveUC.Handle(c.UserContext(), board.MoveColumnCommand{
+		BoardID:  boardID,
+		ColumnID: columnID,
+		Position: req.Position,
+	})
+	if err != nil {
+		return columnErrorResponse(c, err)
+	}
+
+	return c.JSON(column)
+}
+
+// @Summary Delete a column
+// @Description Delete a column and close the gap in positions
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/columns/{columnId} [delete]
+func (h *ColumnHandler) deleteColumn(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	err = h.deleteUC.Handle(c.UserContext(), board.DeleteColumnCommand{
+		BoardID:  boardID,
+		ColumnID: columnID,
+	})
+	if err != nil {
+		return columnErrorResponse(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -1,9 +1,15 @@
 package v1
 
+import (
+	"time"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+)
+
 type CreateBoardRequest struct {
 	Title       string `json:"title" example:"Important thing"`
 	Description string `json:"description" example:"This is my board's description"`
-	Owner       int64  `json:"owner" example:"1"`
+	// Владельцем становится пользователь из JWT; поле owner в теле игнорируется
 }
 
 type UpdateBoardRequest struct {
@@ -11,6 +17,111 @@ type UpdateBoardRequest struct {
 	Description *string `json:"description" example:"This is my board's description"`
 }
 
+type MoveBoardRequest struct {
+	Owner int64 `json:"owner" example:"2"` // Новый владелец; передает доску пользователь из JWT
+}
+
+type CloneBoardRequest struct {
+	// Название копии; если не задано — как у исходной доски
+	Title *string `json:"title" example:"Sprint 12"`
+}
+
+type SetBoardTemplateRequest struct {
+	IsTemplate bool `json:"isTemplate" example:"true"`
+}
+
+type BoardListResponse struct {
+	Boards []*domain.Board `json:"boards"`
+	// Пустой на последней странице
+	NextPageToken string `json:"nextPageToken"`
+}
+
 type ErrBoardNotFoundResponse struct {
 	Error string `json:"error" example:"board not found"`
 }
+
+type CreateColumnRequest struct {
+	Title string `json:"title" example:"To Do"`
+}
+
+type RenameColumnRequest struct {
+	Title string `json:"title" example:"In Progress"`
+}
+
+type MoveColumnRequest struct {
+	Position int `json:"position" example:"0"`
+}
+
+type CreateTaskRequest struct {
+	Title       string `json:"title" example:"Write migration"`
+	Description string `json:"description" example:"Add tasks table"`
+	AssigneeID  int64  `json:"assigneeId" example:"1"`
+}
+
+type UpdateTaskRequest struct {
+	Title       *string `json:"title" example:"Write migration"`
+	Description *string `json:"description" example:"Add tasks table"`
+	AssigneeID  *int64  `json:"assigneeId" example:"1"` // 0 снимает исполнителя
+}
+
+type MoveTaskRequest struct {
+	ColumnID int64 `json:"columnId" example:"2"`
+	Position int   `json:"position" example:"0"`
+}
+
+type AddMemberRequest struct {
+	UserID int64  `json:"userId" example:"2"`
+	Role   string `json:"role" example:"editor"` // admin, editor или viewer
+}
+
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" example:"viewer"`
+}
+
+type CreateInvitationRequest struct {
+	Email string `json:"email" example:"teammate@example.com"`
+	Role  string `json:"role" example:"editor"` // admin, editor или viewer
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" example:"q1w2e3r4t5y6"`
+}
+
+// InvitationResponse — приглашение без хэша токена
+type InvitationResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	BoardID   int64     `json:"boardId" example:"1"`
+	Email     string    `json:"email" example:"teammate@example.com"`
+	Role      string    `json:"role" example:"editor"`
+	InvitedBy int64     `json:"invitedBy" example:"1"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func toInvitationResponse(i *domain.Invitation) InvitationResponse {
+	return InvitationResponse{
+		ID:        i.ID,
+		BoardID:   i.BoardID,
+		Email:     i.Email,
+		Role:      string(i.Role),
+		InvitedBy: i.InvitedBy,
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+}
+
+type ImportIssueResponse struct {
+	Kind     string `json:"kind" example:"card"`
+	SourceID string `json:"sourceId" example:"5f1b2c3d4e5f6a7b8c9d0e1f"`
+	Name     string `json:"name" example:"Write release notes"`
+	Reason   string `json:"reason" example:"card is archived in Trello"`
+}
+
+type ImportReportResponse struct {
+	Board   *domain.Board `json:"board"`
+	Columns int           `json:"columns" example:"4"`
+	Tasks   int           `json:"tasks" example:"37"`
+	// Найденные пользователи приглашены, а не добавлены участниками
+	Invitations int                   `json:"invitations" example:"3"`
+	Issues      []ImportIssueResponse `json:"issues"`
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type ExportHandler struct {
+	exportUC *board.ExportBoardUseCase
+}
+
+func NewExportHandler(api fiber.Router, exportUC *board.ExportBoardUseCase) {
+	handler := &ExportHandler{exportUC: exportUC}
+
+	api.Get("/boards/:id/export", handler.exportBoard)
+}
+
+// @Summary Export a board
+// @Description Download a board snapshot: json is a lossless versioned document, csv is a flat table of tasks, markdown is a checklist
+// @Tags boards
+// @Produce json,text/csv,text/markdown
+// @Param id path int true "Board ID"
+// @Param format query string false "json (default), csv or markdown"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /boards/{id}/export [get]
+func (h *ExportHandler) exportBoard(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	export, err := h.exportUC.Handle(c.UserContext(), board.ExportBoardQuery{
+		BoardID: int64(id),
+		Format:  c.Query("format"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBoardNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, domain.ErrInvalidExportFormat):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, export.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", export.FileName()))
+
+	return export.Write(c.Response().BodyWriter())
+}
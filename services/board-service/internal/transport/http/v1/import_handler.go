@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/transport/http/middleware"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type ImportHandler struct {
+	importTrelloUC *board.ImportTrelloBoardUseCase
+	importBoardUC  *board.ImportBoardUseCase
+}
+
+func NewImportHandler(api fiber.Router, importTrelloUC *board.ImportTrelloBoardUseCase, importBoardUC *board.ImportBoardUseCase) {
+	handler := &ImportHandler{importTrelloUC: importTrelloUC, importBoardUC: importBoardUC}
+
+	api.Post("/boards/import", handler.importBoard)
+	api.Post("/boards/import/trello", handler.importTrello)
+}
+
+// importErrorResponse переводит ошибки импорта в HTTP статусы
+func importErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidImport), errors.Is(err, domain.ErrUnsupportedDocumentVersion),
+		errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// @Summary Import a board document
+// @Description Recreate a board from a JSON document produced by the board export (format=json), e.g. exported in another environment.
+// @Description Columns and tasks get new ids, users are matched by username. Matched members are invited with their former roles; members and assignees without a matching user are listed in the report.
+// @Description Documents of a newer incompatible version are rejected.
+// @Tags import
+// @Accept json,mpfd
+// @Produce json
+// @Param file formData file false "Board JSON document; the raw JSON can be sent as the request body instead"
+// @Success 201 {object} ImportReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /boards/import [post]
+func (h *ImportHandler) importBoard(c *fiber.Ctx) error {
+	ownerID, ok := middleware.UserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
+	document, err := uploadedFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid upload"})
+	}
+
+	report, err := h.importBoardUC.Handle(c.UserContext(), board.ImportBoardCommand{
+		Document: document,
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return importErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toImportReportResponse(report))
+}
+
+// @Summary Import a Trello board
+// @Description Create a board from a Trello board JSON export. Lists become columns, cards become tasks,
+// @Description Trello members are matched to Taskify users by username and invited to the board as editors. Items that cannot be imported are listed in the report.
+// @Tags import
+// @Accept json,mpfd
+// @Produce json
+// @Param file formData file false "Trello JSON export; the raw JSON can be sent as the request body instead"
+// @Success 201 {object} ImportReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /boards/import/trello [post]
+func (h *ImportHandler) importTrello(c *fiber.Ctx) error {
+	ownerID, ok := middleware.UserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
+	export, err := uploadedFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid upload"})
+	}
+
+	report, err := h.importTrelloUC.Handle(c.UserContext(), board.ImportTrelloBoardCommand{
+		Export:  export,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return importErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toImportReportResponse(report))
+}
+
+// uploadedFile читает файл из поля file формы multipart, а для других типов — тело запроса целиком
+func uploadedFile(c *fiber.Ctx) ([]byte, error) {
+	if !strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return c.Body(), nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+func toImportReportResponse(r *board.ImportReport) ImportReportResponse {
+	issues := make([]ImportIssueResponse, 0, len(r.Issues))
+	for _, i := range r.Issues {
+		issues = append(issues, ImportIssueResponse{
+			Kind:     i.Kind,
+			SourceID: i.SourceID,
+			Name:     i.Name,
+			Reason:   i.Reason,
+		})
+	}
+
+	return ImportReportResponse{
+		Board:       r.Board,
+		Columns:     r.Columns,
+		Tasks:       r.Tasks,
+		Invitations: r.Invitations,
+		Issues:      issues,
+	}
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type InvitationHandler struct {
+	createUC *board.CreateInvitationUseCase
+	listUC   *board.ListInvitationsUseCase
+	revokeUC *board.RevokeInvitationUseCase
+	acceptUC *board.AcceptInvitationUseCase
+}
+
+func NewInvitationHandler(api fiber.Router, createUC *board.CreateInvitationUseCase, listUC *board.ListInvitationsUseCase, revokeUC *board.RevokeInvitationUseCase, acceptUC *board.AcceptInvitationUseCase) {
+	handler := &InvitationHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+		acceptUC: acceptUC,
+	}
+
+	invitations := api.Group("/boards/:id/invitations")
+	invitations.Post("/", handler.createInvitation)
+	invitations.Get("/", handler.listInvitations)
+	invitations.Delete("/:invitationId", handler.revokeInvitation)
+
+	// Принимающий еще не участник доски и знает только токен
+	api.Post("/invitations/accept", handler.acceptInvitation)
+}
+
+// invitationErrorResponse переводит доменные ошибки приглашений в HTTP статусы
+func invitationErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrInvitationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrInvitationEmailMismatch):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidEmail), errors.Is(err, domain.ErrInvalidRole),
+		errors.Is(err, domain.ErrInvitationExpired), errors.Is(err, domain.ErrInvitationRevoked),
+		errors.Is(err, domain.ErrInvitationAlreadyUsed), errors.Is(err, domain.ErrOwnerRoleImmutable),
+		errors.Is(err, domain.ErrBoardArchived):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// @Summary Invite to a board
+// @Description Send an invitation with a single-use expiring token to an email
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body CreateInvitationRequest true "Email and role"
+// @Success 201 {object} InvitationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/invitations [post]
+func (h *InvitationHandler) createInvitation(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	invitation, err := h.createUC.Handle(c.UserContext(), board.CreateInvitationCommand{
+		BoardID: int64(boardID),
+		Email:   req.Email,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return invitationErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toInvitationResponse(invitation))
+}
+
+// @Summary List pending invitations
+// @Description Get invitations that are neither accepted, revoked nor expired
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {array} InvitationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/invitations [get]
+func (h *InvitationHandler) listInvitations(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	invitations, err := h.listUC.Handle(c.UserContext(), int64(boardID))
+	if err != nil {
+		return invitationErrorResponse(c, err)
+	}
+
+	response := make([]InvitationResponse, 0, len(invitations))
+	for _, i := range invitations {
+		response = append(response, toInvitationResponse(i))
+	}
+
+	return c.JSON(response)
+}
+
+// @Summary Revoke an invitation
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param invitationId path int true "Invitation ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/invitations/{invitationId} [delete]
+func (h *InvitationHandler) revokeInvitation(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	invitationID, err := c.ParamsInt("invitationId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	err = h.revokeUC.Handle(c.UserContext(), board.RevokeInvitationCommand{
+		BoardID:      int64(boardID),
+		InvitationID: int64(invitationID),
+	})
+	if err != nil {
+		return invitationErrorResponse(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Accept an invitation
+// @Description Join the board with the token from the invitation email. Deleted boards answer 404, archived boards 400
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param request body AcceptInvitationRequest true "Invitation token"
+// @Success 200 {object} board.Member
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /invitations/accept [post]
+func (h *InvitationHandler) acceptInvitation(c *fiber.Ctx) error {
+	var req AcceptInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	member, err := h.acceptUC.Handle(c.UserContext(), req.Token)
+	if err != nil {
+		return invitationErrorResponse(c, err)
+	}
+
+	return c.JSON(member)
+}
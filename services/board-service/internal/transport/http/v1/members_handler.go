@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type MemberHandler struct {
+	listUC       *board.ListMembersUseCase
+	addUC        *board.AddMemberUseCase
+	updateRoleUC *board.UpdateMemberRoleUseCase
+	removeUC     *board.RemoveMemberUseCase
+}
+
+func NewMemberHandler(api fiber.Router, listUC *board.ListMembersUseCase, addUC *board.AddMemberUseCase, updateRoleUC *board.UpdateMemberRoleUseCase, removeUC *board.RemoveMemberUseCase) {
+	handler := &MemberHandler{
+		listUC:       listUC,
+		addUC:        addUC,
+		updateRoleUC: updateRoleUC,
+		removeUC:     removeUC,
+	}
+
+	members := api.Group("/boards/:id/members")
+	members.Get("/", handler.listMembers)
+	members.Post("/", handler.addMember)
+	members.Patch("/:userId", handler.updateMemberRole)
+	members.Delete("/:userId", handler.removeMember)
+}
+
+// memberErrorResponse переводит доменные ошибки участников в HTTP статусы
+func memberErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrMemberNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidRole), errors.Is(err, domain.ErrEmptyMember), errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrOwnerRoleImmutable):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// parseMemberParams читает id доски и пользователя из пути
+func parseMemberParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := c.ParamsInt("userId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(userID), nil
+}
+
+// @Summary List board members
+// @Description Get board members with their roles, most privileged first
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {array} board.Member
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/members [get]
+func (h *MemberHandler) listMembers(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	members, err := h.listUC.Handle(c.UserContext(), int64(boardID))
+	if err != nil {
+		return memberErrorResponse(c, err)
+	}
+
+	return c.JSON(members)
+}
+
+// @Summary Add a board member
+// @Description Add a user to the board with role admin, editor or viewer
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body AddMemberRequest true "User and role"
+// @Success 201 {object} board.Member
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/members [post]
+func (h *MemberHandler) addMember(c *fiber.Ctx) error {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req AddMemberRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	member, err := h.addUC.Handle(c.UserContext(), board.AddMemberCommand{
+		BoardID: int64(boardID),
+		UserID:  req.UserID,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return memberErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(member)
+}
+
+// @Summary Change a member role
+// @Description Change the role of a board member; the owner role changes only by board transfer
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param userId path int true "User ID"
+// @Param request body UpdateMemberRoleRequest true "New role"
+// @Success 200 {object} board.Member
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/members/{userId} [patch]
+func (h *MemberHandler) updateMemberRole(c *fiber.Ctx) error {
+	boardID, userID, err := parseMemberParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req UpdateMemberRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	member, err := h.updateRoleUC.Handle(c.UserContext(), board.UpdateMemberRoleCommand{
+		BoardID: boardID,
+		UserID:  userID,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return memberErrorResponse(c, err)
+	}
+
+	return c.JSON(member)
+}
+
+// @Summary Remove a board member
+// @Description Remove a member from the board; any member can remove themselves
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param userId path int true "User ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/members/{userId} [delete]
+func (h *MemberHandler) removeMember(c *fiber.Ctx) error {
+	boardID, userID, err := parseMemberParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	err = h.removeUC.Handle(c.UserContext(), board.RemoveMemberCommand{
+		BoardID: boardID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return memberErrorResponse(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type TaskHandler struct {
+	createUC *board.CreateTaskUseCase
+	getUC    *board.GetTaskUseCase
+	updateUC *board.UpdateTaskUseCase
+	deleteUC *board.DeleteTaskUseCase
+	moveUC   *board.MoveTaskUseCase
+}
+
+func NewTaskHandler(api fiber.Router, createUC *board.CreateTaskUseCase, getUC *board.GetTaskUseCase, updateUC *board.UpdateTaskUseCase, deleteUC *board.DeleteTaskUseCase, moveUC *board.MoveTaskUseCase) {
+	handler := &TaskHandler{
+		createUC: createUC,
+		getUC:    getUC,
+		updateUC: updateUC,
+		deleteUC: deleteUC,
+		moveUC:   moveUC,
+	}
+
+	boardRoutes := api.Group("/boards/:id")
+	boardRoutes.Post("/columns/:columnId/tasks", handler.createTask)
+	boardRoutes.Get("/tasks/:taskId", handler.getTask)
+	boardRoutes.Patch("/tasks/:taskId", handler.updateTask)
+	boardRoutes.Delete("/tasks/:taskId", handler.deleteTask)
+	boardRoutes.Post("/tasks/:taskId/move", handler.moveTask)
+}
+
+// taskErrorResponse переводит доменные ошибки задач в HTTP статусы
+func taskErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound), errors.Is(err, domain.ErrColumnNotFound), errors.Is(err, domain.ErrTaskNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrTaskTitleRequired), errors.Is(err, domain.ErrTaskTitleTooLong),
+		errors.Is(err, domain.ErrAssigneeNotFound), errors.Is(err, domain.ErrInvalidPosition):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// parseTaskParams читает id доски и задачи из пути
+func parseTaskParams(c *fiber.Ctx) (int64, int64, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	taskID, err := c.ParamsInt("taskId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(boardID), int64(taskID), nil
+}
+
+// @Summary Create a task
+// @Description Append a new task to the end of the column
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param columnId path int true "Column ID"
+// @Param request body CreateTaskRequest true "Task creation info"
+// @Success 201 {object} board.Task
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/columns/{columnId}/tasks [post]
+func (h *TaskHandler) createTask(c *fiber.Ctx) error {
+	boardID, columnID, err := parseColumnParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req CreateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	task, err := h.createUC.Handle(c.UserContext(), board.CreateTaskCommand{
+		BoardID:     boardID,
+		ColumnID:    columnID,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeID:  req.AssigneeID,
+	})
+	if err != nil {
+		return taskErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(task)
+}
+
+// @Summary Get a task
+// @Description Get a task of the board by ID
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Success 200 {object} board.Task
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/tasks/{taskId} [get]
+func (h *TaskHandler) getTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	task, err := h.getUC.Handle(c.UserContext(), board.GetTaskQuery{
+		BoardID: boardID,
+		TaskID:  taskID,
+	})
+	if err != nil {
+		return taskErrorResponse(c, err)
+	}
+
+	return c.JSON(task)
+}
+
+// @Summary Update a task
+// @Description Update a task with optional fields: title, description, assigneeId
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Param request body UpdateTaskRequest true "Task update info"
+// @Success 200 {object} board.Task
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/tasks/{taskId} [patch]
+func (h *TaskHandler) updateTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req UpdateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	task, err := h.updateUC.Handle(c.UserContext(), board.UpdateTaskCommand{
+		BoardID:     boardID,
+		TaskID:      taskID,
+		Title:       req.Title,
+		Description: req.Description,
+		AssigneeID:  req.AssigneeID,
+	})
+	if err != nil {
+		return taskErrorResponse(c, err)
+	}
+
+	return c.JSON(task)
+}
+
+// @Summary Delete a task
+// @Description Delete a task and close the gap in its column
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/tasks/{taskId} [delete]
+func (h *TaskHandler) deleteTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	err = h.deleteUC.Handle(c.UserContext(), board.DeleteTaskCommand{
+		BoardID: boardID,
+		TaskID:  taskID,
+	})
+	if err != nil {
+		return taskErrorResponse(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary Move a task
+// @Description Move a task to another column and/or position
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param taskId path int true "Task ID"
+// @Param request body MoveTaskRequest true "Target column and position (zero-based)"
+// @Success 200 {object} board.Task
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /boards/{id}/tasks/{taskId}/move [post]
+func (h *TaskHandler) moveTask(c *fiber.Ctx) error {
+	boardID, taskID, err := parseTaskParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req MoveTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	task, err := h.moveUC.Handle(c.UserContext(), board.MoveTaskCommand{
+		BoardID:  boardID,
+		TaskID:   taskID,
+		ColumnID: req.ColumnID,
+		Position: req.Position,
+	})
+	if err != nil {
+		return taskErrorResponse(c, err)
+	}
+
+	return c.JSON(task)
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"Taskify/pkg/etag"
+	domain "Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/transport/http/middleware"
+	"Taskify/services/board-service/internal/usecase/board"
+)
+
+type TemplateHandler struct {
+	cloneUC       *board.CloneBoardUseCase
+	setTemplateUC *board.SetBoardTemplateUseCase
+	listUC        *board.ListTemplatesUseCase
+}
+
+func NewTemplateHandler(api fiber.Router, cloneUC *board.CloneBoardUseCase, setTemplateUC *board.SetBoardTemplateUseCase, listUC *board.ListTemplatesUseCase) {
+	handler := &TemplateHandler{
+		cloneUC:       cloneUC,
+		setTemplateUC: setTemplateUC,
+		listUC:        listUC,
+	}
+
+	api.Post("/boards/:id/clone", handler.cloneBoard)
+	api.Put("/boards/:id/template", handler.setBoardTemplate)
+	api.Get("/templates", handler.listTemplates)
+}
+
+// templateErrorResponse переводит ошибки клонирования и шаблонов в HTTP статусы
+func templateErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrTitleRequired), errors.Is(err, domain.ErrTitleTooLong):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrVersionConflict):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// @Summary Clone a board
+// @Description Copy a board or template with its columns and tasks into a new board owned by the caller
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Source board ID"
+// @Param request body CloneBoardRequest false "Optional title of the copy"
+// @Success 201 {object} board.Board
+// @Header 201 {string} ETag "Board version"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /boards/{id}/clone [post]
+func (h *TemplateHandler) cloneBoard(c *fiber.Ctx) error {
+	ownerID, ok := middleware.UserID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthenticated"})
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	// Тело необязательно: без него копия получает название исходной доски
+	var req CloneBoardRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+	}
+
+	b, err := h.cloneUC.Handle(c.UserContext(), board.CloneBoardCommand{
+		SourceID: int64(id),
+		Title:    req.Title,
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return templateErrorResponse(c, err)
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.Status(fiber.StatusCreated).JSON(b)
+}
+
+// @Summary Mark a board as template
+// @Description Mark a board as template or clear the mark
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body SetBoardTemplateRequest true "Template flag"
+// @Success 200 {object} board.Board
+// @Header 200 {string} ETag "Board version"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /boards/{id}/template [put]
+func (h *TemplateHandler) setBoardTemplate(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var req SetBoardTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	b, err := h.setTemplateUC.Handle(c.UserContext(), board.SetBoardTemplateCommand{
+		BoardID:    int64(id),
+		IsTemplate: req.IsTemplate,
+	})
+	if err != nil {
+		return templateErrorResponse(c, err)
+	}
+
+	etag.SetVersion(c, b.Version)
+
+	return c.JSON(b)
+}
+
+// @Summary List templates
+// @Description Get template boards the caller owns or is a member of
+// @Tags templates
+// @Produce json
+// @Success 200 {array} board.Board
+// @Failure 403 {object} map[string]string
+// @Router /templates [get]
+func (h *TemplateHandler) listTemplates(c *fiber.Ctx) error {
+	templates, err := h.listUC.Handle(c.UserContext())
+	if err != nil {
+		return templateErrorResponse(c, err)
+	}
+
+	return c.JSON(templates)
+}
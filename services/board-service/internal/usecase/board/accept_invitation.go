@@ -0,0 +1,91 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+type AcceptInvitationUseCase struct {
+	tx             TxManager
+	invitationRepo board.InvitationRepository
+	memberRepo     board.MemberRepository
+	userRepo       board.UserRepository
+	tokens         InvitationTokens
+	boardRepo      board.Repository
+}
+
+func NewAcceptInvitationUseCase(tx TxManager, invitationRepo board.InvitationRepository, memberRepo board.MemberRepository, userRepo board.UserRepository, tokens InvitationTokens, boardRepo board.Repository) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{tx: tx, invitationRepo: invitationRepo, memberRepo: memberRepo, userRepo: userRepo, tokens: tokens, boardRepo: boardRepo}
+}
+
+// Handle принимает приглашение от имени вызывающего пользователя и делает его участником доски.
+// Токен одноразовый: строка приглашения блокируется, и второй запрос увидит его уже принятым.
+// На удаленную или архивную доску приглашение не принимается: доска блокируется,
+// чтобы её не удалили и не убрали в архив, пока участник добавляется.
+//
+// Блокировки берутся в том же порядке, что при создании и отзыве приглашений:
+// сначала доска, потом приглашение. Иначе встречные транзакции взаимно блокируются.
+func (uc *AcceptInvitationUseCase) Handle(ctx context.Context, token string) (*board.Member, error) {
+	userID, ok := identity.UserID(ctx)
+	if !ok {
+		return nil, board.ErrForbidden
+	}
+
+	if token == "" {
+		return nil, board.ErrInvitationNotFound
+	}
+
+	var member *board.Member
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		hash := uc.tokens.Hash(token)
+
+		// Доска приглашения не меняется, поэтому её можно узнать без блокировки
+		found, err := uc.invitationRepo.GetByTokenHash(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, found.BoardID)
+		if err != nil {
+			return err
+		}
+
+		invitation, err := uc.invitationRepo.GetByTokenHashForUpdate(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		if b.IsArchived() {
+			return board.ErrBoardArchived
+		}
+
+		email, err := uc.userRepo.GetEmail(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := invitation.Accept(userID, email, time.Now()); err != nil {
+			return err
+		}
+
+		member, err = board.NewMember(invitation.BoardID, userID, invitation.Role)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.memberRepo.Create(ctx, member); err != nil {
+			return err
+		}
+
+		return uc.invitationRepo.Update(ctx, invitation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
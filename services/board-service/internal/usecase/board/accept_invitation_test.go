@@ -0,0 +1,71 @@
+package board
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// plainTokens хэширует токен в самого себя
+type plainTokens struct{}
+
+func (plainTokens) Generate() (string, string, error) { return "token", "token", nil }
+func (plainTokens) Hash(token string) string          { return token }
+
+func TestAcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name  string
+		state func(b *board.Board)
+		err   error
+	}{
+		{name: "active board", state: func(b *board.Board) {}},
+		{name: "archived board", state: func(b *board.Board) { _ = b.Archive(time.Now()) }, err: board.ErrBoardArchived},
+		{name: "deleted board", state: func(b *board.Board) { _ = b.SoftDelete(time.Now()) }, err: board.ErrBoardNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, _ := f.seedBoard(1, "Board", nil)
+			f.store.addUser(2, "guest")
+
+			invitation, err := board.NewInvitation(b.ID, "guest@example.com", board.RoleEditor, 1, "token", time.Hour)
+			if err != nil {
+				t.Fatalf("new invitation: %v", err)
+			}
+			if err := f.invitations.Create(as(1), invitation); err != nil {
+				t.Fatalf("create invitation: %v", err)
+			}
+			f.store.updateBoard(b.ID, tt.state)
+
+			uc := NewAcceptInvitationUseCase(f.store, f.invitations, f.members, f.users, plainTokens{}, f.boards)
+			member, err := uc.Handle(as(2), "token")
+
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				// Приглашение остается действующим: доску могут вернуть из архива или корзины
+				if pending := f.store.pendingInvitations(b.ID); len(pending) != 1 {
+					t.Errorf("pending invitations = %d, want 1", len(pending))
+				}
+				if _, err := f.members.Get(as(1), b.ID, 2); !errors.Is(err, board.ErrMemberNotFound) {
+					t.Errorf("member lookup err = %v, want ErrMemberNotFound", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if member.BoardID != b.ID || member.UserID != 2 || member.Role != board.RoleEditor {
+				t.Errorf("member = %+v, want editor 2 of board %d", member, b.ID)
+			}
+			if pending := f.store.pendingInvitations(b.ID); len(pending) != 0 {
+				t.Errorf("pending invitations = %d, want 0", len(pending))
+			}
+		})
+	}
+}
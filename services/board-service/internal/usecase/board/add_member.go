@@ -0,0 +1,82 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type AddMemberUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	memberRepo board.MemberRepository
+	userRepo   board.UserRepository
+	auth       *Authorizer
+}
+
+func NewAddMemberUseCase(tx TxManager, boardRepo board.Repository, memberRepo board.MemberRepository, userRepo board.UserRepository, auth *Authorizer) *AddMemberUseCase {
+	return &AddMemberUseCase{tx: tx, boardRepo: boardRepo, memberRepo: memberRepo, userRepo: userRepo, auth: auth}
+}
+
+// Handle добавляет пользователя на доску с заданной ролью.
+// Админ может добавлять только редакторов и зрителей.
+func (uc *AddMemberUseCase) Handle(ctx context.Context, cmd AddMemberCommand) (*board.Member, error) {
+	role, err := board.ParseRole(cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == board.RoleOwner {
+		return nil, board.ErrOwnerRoleImmutable
+	}
+
+	member, err := board.NewMember(cmd.BoardID, cmd.UserID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		callerRole, err := managerRole(ctx, uc.auth, b)
+		if err != nil {
+			return err
+		}
+
+		if !callerRole.CanAssign(role) {
+			return board.ErrForbidden
+		}
+
+		exists, err := uc.userRepo.Exists(ctx, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return board.ErrUserNotFound
+		}
+
+		return uc.memberRepo.Create(ctx, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// managerRole возвращает роль вызывающего пользователя, если она позволяет управлять участниками
+func managerRole(ctx context.Context, auth *Authorizer, b *board.Board) (board.Role, error) {
+	role, err := auth.Role(ctx, b)
+	if err != nil {
+		return "", err
+	}
+
+	if !role.Allows(board.PermissionManageMembers) {
+		return "", board.ErrForbidden
+	}
+
+	return role, nil
+}
@@ -0,0 +1,55 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// ArchiveBoardUseCase убирает доску в архив: она пропадает из списка по умолчанию,
+// но остается доступна по id и через фильтр archived
+type ArchiveBoardUseCase struct {
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
+}
+
+func NewArchiveBoardUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *ArchiveBoardUseCase {
+	return &ArchiveBoardUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *ArchiveBoardUseCase) Handle(ctx context.Context, id int64) (*board.Board, error) {
+	var archived *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.repo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionArchive); err != nil {
+			return err
+		}
+
+		if err := b.Archive(time.Now()); err != nil {
+			return err
+		}
+
+		archived, err = uc.repo.Update(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardArchived(archived))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, id)
+
+	return archived, nil
+}
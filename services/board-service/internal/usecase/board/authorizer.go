@@ -0,0 +1,83 @@
+package board
+
+import (
+	"context"
+	"errors"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+// Authorizer решает, может ли вызывающий пользователь работать с доской.
+// Пользователь берется из context (его кладет транспорт), а не из команды,
+// поэтому ни один сценарий не может подставить чужой id.
+type Authorizer struct {
+	memberRepo board.MemberRepository
+}
+
+func NewAuthorizer(memberRepo board.MemberRepository) *Authorizer {
+	return &Authorizer{memberRepo: memberRepo}
+}
+
+// Role возвращает роль вызывающего пользователя на доске.
+// Не участник и анонимный запрос получают board.ErrForbidden.
+func (a *Authorizer) Role(ctx context.Context, b *board.Board) (board.Role, error) {
+	userID, ok := identity.UserID(ctx)
+	if !ok {
+		return "", board.ErrForbidden
+	}
+
+	// Владелец определяется самой доской — так он не зависит от строки в board_members
+	if b.Owner == userID {
+		return board.RoleOwner, nil
+	}
+
+	member, err := a.memberRepo.Get(ctx, b.ID, userID)
+	if err != nil {
+		if errors.Is(err, board.ErrMemberNotFound) {
+			return "", board.ErrForbidden
+		}
+		return "", err
+	}
+
+	return member.Role, nil
+}
+
+// Authorize возвращает board.ErrForbidden, если роль вызывающего пользователя не дает права p
+func (a *Authorizer) Authorize(ctx context.Context, b *board.Board, p board.Permission) error {
+	role, err := a.Role(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	if !role.Allows(p) {
+		return board.ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthorizedBoardStructureReader проверяет доступ к уже прочитанной структуре.
+// Стоит поверх кэша: кэш общий для всех пользователей, поэтому проверка
+// должна выполняться на каждом запросе, а не только при загрузке из БД.
+type AuthorizedBoardStructureReader struct {
+	next BoardStructureReader
+	auth *Authorizer
+}
+
+func NewAuthorizedBoardStructureReader(next BoardStructureReader, auth *Authorizer) *AuthorizedBoardStructureReader {
+	return &AuthorizedBoardStructureReader{next: next, auth: auth}
+}
+
+func (r *AuthorizedBoardStructureReader) Handle(ctx context.Context, id int64) (*board.Structure, error) {
+	structure, err := r.next.Handle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.auth.Authorize(ctx, &structure.Board, board.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return structure, nil
+}
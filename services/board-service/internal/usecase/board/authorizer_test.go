@@ -0,0 +1,90 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestBoardAccess(t *testing.T) {
+	callers := []struct {
+		name string
+		ctx  context.Context
+		err  error
+	}{
+		{name: "owner", ctx: as(ownerID)},
+		{name: "another user", ctx: as(newOwnerID), err: board.ErrForbidden},
+		{name: "anonymous", ctx: context.Background(), err: board.ErrForbidden},
+	}
+
+	title := "Renamed"
+	actions := []struct {
+		name string
+		run  func(ctx context.Context, f *fixture, b *board.Board) error
+	}{
+		{
+			name: "get board",
+			run: func(ctx context.Context, f *fixture, b *board.Board) error {
+				_, err := NewGetBoardUseCase(f.boards, f.cache, f.auth).Handle(ctx, b.ID)
+				return err
+			},
+		},
+		{
+			name: "get board structure",
+			run: func(ctx context.Context, f *fixture, b *board.Board) error {
+				reader := NewAuthorizedBoardStructureReader(NewGetBoardStructureUseCase(f.boards, f.columns, f.tasks), f.auth)
+				_, err := reader.Handle(ctx, b.ID)
+				return err
+			},
+		},
+		{
+			name: "update board",
+			run: func(ctx context.Context, f *fixture, b *board.Board) error {
+				_, err := NewUpdateBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, UpdateBoardCommand{ID: b.ID, Title: &title})
+				return err
+			},
+		},
+		{
+			name: "delete board",
+			run: func(ctx context.Context, f *fixture, b *board.Board) error {
+				return NewDeleteBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, b.ID)
+			},
+		},
+	}
+
+	for _, action := range actions {
+		for _, caller := range callers {
+			t.Run(action.name+"/"+caller.name, func(t *testing.T) {
+				f := newFixture()
+				b, _ := f.seedBoard(ownerID, "Board", map[string][]string{"Todo": {"a"}})
+				f.store.events = nil
+
+				err := action.run(caller.ctx, f, b)
+				if !errors.Is(err, caller.err) {
+					t.Fatalf("err = %v, want %v", err, caller.err)
+				}
+				// Отказ ничего не меняет на доске
+				if caller.err != nil && len(f.store.events) != 0 {
+					t.Errorf("events = %v after forbidden call", f.store.eventTypes())
+				}
+			})
+		}
+	}
+}
+
+// Структура в кэше общая для всех пользователей, поэтому доступ проверяется
+// и тогда, когда доску в кэш уже положил её владелец.
+func TestAuthorizedStructureReaderOverCache(t *testing.T) {
+	f := newFixture()
+	b, _ := f.seedBoard(ownerID, "Board", nil)
+
+	reader := NewAuthorizedBoardStructureReader(NewCachedBoardStructureReader(NewGetBoardStructureUseCase(f.boards, f.columns, f.tasks), f.cache, testFreshTTL, testStaleTTL), f.auth)
+	if _, err := reader.Handle(as(ownerID), b.ID); err != nil {
+		t.Fatalf("owner: %v", err)
+	}
+	if _, err := reader.Handle(as(newOwnerID), b.ID); !errors.Is(err, board.ErrForbidden) {
+		t.Fatalf("another user err = %v, want %v", err, board.ErrForbidden)
+	}
+}
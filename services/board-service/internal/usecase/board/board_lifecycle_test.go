@@ -0,0 +1,146 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestBoardLifecycleAccess(t *testing.T) {
+	type action func(ctx context.Context, f *fixture, id int64) error
+
+	archive := func(ctx context.Context, f *fixture, id int64) error {
+		_, err := NewArchiveBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, id)
+		return err
+	}
+	unarchive := func(ctx context.Context, f *fixture, id int64) error {
+		_, err := NewUnarchiveBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, id)
+		return err
+	}
+	remove := func(ctx context.Context, f *fixture, id int64) error {
+		return NewDeleteBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, id)
+	}
+	restore := func(ctx context.Context, f *fixture, id int64) error {
+		_, err := NewRestoreBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, id)
+		return err
+	}
+
+	archived := func(b *board.Board) { _ = b.Archive(time.Now()) }
+	deleted := func(b *board.Board) { _ = b.SoftDelete(time.Now()) }
+	active := func(b *board.Board) {}
+
+	tests := []struct {
+		name   string
+		state  func(b *board.Board)
+		action action
+		caller int64
+		err    error
+	}{
+		{name: "owner archives", state: active, action: archive, caller: ownerID},
+		{name: "admin archives", state: active, action: archive, caller: adminID},
+		{name: "editor archives", state: active, action: archive, caller: editorID, err: board.ErrForbidden},
+		{name: "archive twice", state: archived, action: archive, caller: ownerID, err: board.ErrBoardAlreadyArchived},
+		{name: "admin unarchives", state: archived, action: unarchive, caller: adminID},
+		{name: "viewer unarchives", state: archived, action: unarchive, caller: viewerID, err: board.ErrForbidden},
+		{name: "unarchive active", state: active, action: unarchive, caller: ownerID, err: board.ErrBoardNotArchived},
+		{name: "owner deletes", state: active, action: remove, caller: ownerID},
+		{name: "admin deletes", state: active, action: remove, caller: adminID, err: board.ErrForbidden},
+		{name: "delete twice", state: deleted, action: remove, caller: ownerID, err: board.ErrBoardNotFound},
+		{name: "owner restores", state: deleted, action: restore, caller: ownerID},
+		{name: "admin restores", state: deleted, action: restore, caller: adminID, err: board.ErrForbidden},
+		{name: "restore active", state: active, action: restore, caller: ownerID, err: board.ErrBoardNotDeleted},
+		{name: "archive deleted", state: deleted, action: archive, caller: ownerID, err: board.ErrBoardNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			f.store.updateBoard(b.ID, tt.state)
+			before := f.store.boards[b.ID]
+
+			err := tt.action(as(tt.caller), f, b.ID)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			after := f.store.boards[b.ID]
+			if changed := after.Version != before.Version; changed != (tt.err == nil) {
+				t.Errorf("version %d -> %d, want changed %v", before.Version, after.Version, tt.err == nil)
+			}
+		})
+	}
+}
+
+// Удаленная доска возвращается целиком: с колонками, задачами и участниками
+func TestRestoreBoardKeepsContent(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(ownerID, "Board", map[string][]string{"Todo": {"a", "b"}}, "Todo")
+	f.store.ensureUser(editorID)
+	if err := f.members.Create(as(ownerID), &board.Member{BoardID: b.ID, UserID: editorID, Role: board.RoleEditor}); err != nil {
+		t.Fatalf("add member: %v", err)
+	}
+
+	if err := NewDeleteBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(as(ownerID), b.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := NewGetBoardUseCase(f.boards, f.cache, f.auth).Handle(as(ownerID), b.ID); !errors.Is(err, board.ErrBoardNotFound) {
+		t.Fatalf("get deleted err = %v, want %v", err, board.ErrBoardNotFound)
+	}
+
+	if _, err := NewRestoreBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(as(ownerID), b.ID); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if titles, _ := f.taskTitles(columns[0].ID); !slices.Equal(titles, []string{"a", "b"}) {
+		t.Errorf("tasks = %v, want [a b]", titles)
+	}
+	if f.role(b.ID, editorID) != board.RoleEditor {
+		t.Errorf("editor role = %q after restore", f.role(b.ID, editorID))
+	}
+}
+
+func TestPurgeDeletedBoards(t *testing.T) {
+	const retention = 30 * 24 * time.Hour
+
+	tests := []struct {
+		name string
+		// deletedAgo — сколько назад удалены доски; 0 — доска не удалена
+		deletedAgo []time.Duration
+		batchSize  int
+		purged     int
+		left       int
+	}{
+		{name: "nothing to purge", deletedAgo: []time.Duration{0, time.Hour}, batchSize: 10, purged: 0, left: 2},
+		{name: "past retention", deletedAgo: []time.Duration{0, retention + time.Hour, time.Hour}, batchSize: 10, purged: 1, left: 2},
+		{name: "one batch at a time", deletedAgo: []time.Duration{retention + time.Hour, retention + 2*time.Hour, retention + 3*time.Hour}, batchSize: 2, purged: 2, left: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			for _, ago := range tt.deletedAgo {
+				b, _ := f.seedBoard(ownerID, "Board", map[string][]string{"Todo": {"a"}})
+				if ago > 0 {
+					f.store.updateBoard(b.ID, func(b *board.Board) { _ = b.SoftDelete(time.Now().Add(-ago)) })
+				}
+			}
+
+			purged, err := NewPurgeDeletedBoardsUseCase(f.store, f.boards, retention, tt.batchSize).Handle(context.Background())
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if len(purged) != tt.purged || len(f.store.boards) != tt.left {
+				t.Errorf("purged = %v, boards left = %d, want %d purged and %d left", purged, len(f.store.boards), tt.purged, tt.left)
+			}
+			for _, c := range f.store.columns {
+				if _, ok := f.store.boards[c.BoardID]; !ok {
+					t.Errorf("column %d of purged board %d left", c.ID, c.BoardID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// CachedStructure — структура доски вместе с моментом, когда её положили в кэш.
+// По StoredAt читатель решает, свежая запись или уже устаревшая.
+type CachedStructure struct {
+	Structure *board.Structure
+	StoredAt  time.Time
+}
+
+// BoardCache — кэш полной структуры доски.
+// Get возвращает (nil, nil), если записи нет.
+//
+// Каждый Invalidate увеличивает поколение доски. Читатель берет поколение до запроса
+// к БД и передает его в Set: если за время чтения доску успели изменить, поколение
+// уже другое, и прочитанная до изменения структура в кэш не попадает.
+type BoardCache interface {
+	Get(ctx context.Context, boardID int64) (*CachedStructure, error)
+	Generation(ctx context.Context, boardID int64) (int64, error)
+	// Set сохраняет структуру, только если поколение доски всё еще равно generation
+	Set(ctx context.Context, structure *board.Structure, generation int64) error
+	Invalidate(ctx context.Context, boardID int64) error
+}
+
+// invalidateBoard сбрасывает кэш доски после успешной записи.
+// Ошибку кэша не отдаем наружу: изменение уже сохранено в БД,
+// а устаревшая запись всё равно истечет по TTL.
+func invalidateBoard(ctx context.Context, cache BoardCache, boardID int64) {
+	if err := cache.Invalidate(ctx, boardID); err != nil {
+		log.Warn().Err(err).Int64("board_id", boardID).Msg("failed to invalidate board cache")
+	}
+}
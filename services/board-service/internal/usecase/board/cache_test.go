@@ -0,0 +1,228 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// writeCase — пишущий use case, вызванный владельцем на доске с колонками Todo и Done
+type writeCase struct {
+	name string
+	// event — событие, которое use case кладет в outbox
+	event board.EventType
+	// prepare меняет доску перед вызовом, например архивирует её
+	prepare func(f *fixture, b *board.Board)
+	run     func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error
+}
+
+const ownerID, newOwnerID = 1, 2
+
+func writeCases() []writeCase {
+	title := "Renamed"
+	description := "Updated"
+
+	return []writeCase{
+		{
+			name:  "update board",
+			event: board.EventBoardUpdated,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewUpdateBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).
+					Handle(ctx, UpdateBoardCommand{ID: b.ID, Title: &title})
+				return err
+			},
+		},
+		{
+			name:  "set board template",
+			event: board.EventBoardUpdated,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewSetBoardTemplateUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).
+					Handle(ctx, SetBoardTemplateCommand{BoardID: b.ID, IsTemplate: true})
+				return err
+			},
+		},
+		{
+			name:  "move board",
+			event: board.EventBoardMoved,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				f.store.ensureUser(newOwnerID)
+				_, err := NewMoveBoardUseCase(f.store, f.boards, f.members, f.users, f.transfers, f.outbox, f.cache, f.auth).
+					Handle(ctx, MoveBoardCommand{ID: b.ID, Owner: newOwnerID, MovedBy: ownerID})
+				return err
+			},
+		},
+		{
+			name:  "archive board",
+			event: board.EventBoardArchived,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewArchiveBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, b.ID)
+				return err
+			},
+		},
+		{
+			name:  "unarchive board",
+			event: board.EventBoardUnarchived,
+			prepare: func(f *fixture, b *board.Board) {
+				f.store.updateBoard(b.ID, func(b *board.Board) {
+					now := time.Now()
+					b.ArchivedAt = &now
+				})
+			},
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewUnarchiveBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, b.ID)
+				return err
+			},
+		},
+		{
+			name:  "delete board",
+			event: board.EventBoardDeleted,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				return NewDeleteBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, b.ID)
+			},
+		},
+		{
+			name:  "restore board",
+			event: board.EventBoardRestored,
+			prepare: func(f *fixture, b *board.Board) {
+				f.store.updateBoard(b.ID, func(b *board.Board) {
+					now := time.Now()
+					b.DeletedAt = &now
+				})
+			},
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewRestoreBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).Handle(ctx, b.ID)
+				return err
+			},
+		},
+		{
+			name:  "create column",
+			event: board.EventColumnCreated,
+			run: func(ctx context.Context, f *fixture, b *board.Board, _ []*board.Column) error {
+				_, err := NewCreateColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth).
+					Handle(ctx, CreateColumnCommand{BoardID: b.ID, Title: "Review"})
+				return err
+			},
+		},
+		{
+			name:  "rename column",
+			event: board.EventColumnRenamed,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				_, err := NewRenameColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth).
+					Handle(ctx, RenameColumnCommand{BoardID: b.ID, ColumnID: columns[0].ID, Title: title})
+				return err
+			},
+		},
+		{
+			name:  "move column",
+			event: board.EventColumnMoved,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				_, err := NewMoveColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth).
+					Handle(ctx, MoveColumnCommand{BoardID: b.ID, ColumnID: columns[0].ID, Position: 1})
+				return err
+			},
+		},
+		{
+			name:  "delete column",
+			event: board.EventColumnDeleted,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				return NewDeleteColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth).
+					Handle(ctx, DeleteColumnCommand{BoardID: b.ID, ColumnID: columns[1].ID})
+			},
+		},
+		{
+			name:  "create task",
+			event: board.EventTaskCreated,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				_, err := NewCreateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+					Handle(ctx, CreateTaskCommand{BoardID: b.ID, ColumnID: columns[0].ID, Title: "New"})
+				return err
+			},
+		},
+		{
+			name:  "update task",
+			event: board.EventTaskUpdated,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				task := f.store.columnTasks(columns[0].ID)[0]
+				_, err := NewUpdateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+					Handle(ctx, UpdateTaskCommand{BoardID: b.ID, TaskID: task.ID, Description: &description})
+				return err
+			},
+		},
+		{
+			name:  "move task",
+			event: board.EventTaskMoved,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				task := f.store.columnTasks(columns[0].ID)[0]
+				_, err := NewMoveTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+					Handle(ctx, MoveTaskCommand{BoardID: b.ID, TaskID: task.ID, ColumnID: columns[1].ID, Position: 0})
+				return err
+			},
+		},
+		{
+			name:  "delete task",
+			event: board.EventTaskDeleted,
+			run: func(ctx context.Context, f *fixture, b *board.Board, columns []*board.Column) error {
+				task := f.store.columnTasks(columns[0].ID)[0]
+				return NewDeleteTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+					Handle(ctx, DeleteTaskCommand{BoardID: b.ID, TaskID: task.ID})
+			},
+		},
+	}
+}
+
+// runWriteCase создает доску владельца и вызывает на ней use case
+func runWriteCase(t *testing.T, tt writeCase) (*fixture, *board.Board) {
+	t.Helper()
+
+	f := newFixture()
+	b, columns := f.seedBoard(ownerID, "Board", map[string][]string{
+		"Todo": {"First", "Second"},
+		"Done": {"Third"},
+	}, "Todo", "Done")
+
+	if tt.prepare != nil {
+		tt.prepare(f, b)
+	}
+
+	// События создания доски в тесте не нужны
+	f.store.events = nil
+
+	if err := tt.run(as(ownerID), f, b, columns); err != nil {
+		t.Fatalf("use case failed: %v", err)
+	}
+
+	return f, b
+}
+
+func TestWriteUseCasesInvalidateCache(t *testing.T) {
+	for _, tt := range writeCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			f, b := runWriteCase(t, tt)
+
+			if got := f.cache.invalidations(b.ID); got != 1 {
+				t.Fatalf("cache invalidated %d times, want 1", got)
+			}
+		})
+	}
+}
+
+func TestFailedWriteKeepsCache(t *testing.T) {
+	f := newFixture()
+	b, _ := f.seedBoard(1, "Board", nil)
+
+	staleVersion := b.Version - 1
+	title := "Renamed"
+
+	_, err := NewUpdateBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).
+		Handle(as(1), UpdateBoardCommand{ID: b.ID, Title: &title, ExpectedVersion: &staleVersion})
+	if !errors.Is(err, board.ErrVersionConflict) {
+		t.Fatalf("err = %v, want ErrVersionConflict", err)
+	}
+
+	if got := f.cache.invalidations(b.ID); got != 0 {
+		t.Fatalf("cache invalidated %d times after a failed write, want 0", got)
+	}
+}
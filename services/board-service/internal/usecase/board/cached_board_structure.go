@@ -0,0 +1,134 @@
+package board
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// refreshTimeout ограничивает фоновое обновление кэша, которое уже не привязано к запросу
+const refreshTimeout = 10 * time.Second
+
+// BoardStructureReader — чтение полной структуры доски
+type BoardStructureReader interface {
+	Handle(ctx context.Context, id int64) (*board.Structure, error)
+}
+
+var _ BoardStructureReader = (*GetBoardStructureUseCase)(nil)
+
+// CachedBoardStructureReader — read-through кэш поверх чтения структуры доски.
+// Если Redis недоступен, читаем напрямую из БД.
+//
+// Защита от stampede: одновременные промахи по одной доске схлопываются
+// в один запрос к БД (singleflight), остальные ждут его результат.
+// Если включен staleWhileRevalidate, устаревшая запись отдается сразу,
+// а обновление идет в фоне — тоже одно на доску.
+type CachedBoardStructureReader struct {
+	next  BoardStructureReader
+	cache BoardCache
+	group singleflight.Group
+
+	freshTTL             time.Duration
+	staleWhileRevalidate time.Duration
+}
+
+// NewCachedBoardStructureReader: freshTTL — сколько запись считается свежей,
+// staleWhileRevalidate — сколько после этого её ещё можно отдавать, пока идет обновление
+// (0 — выключено). Кэш должен хранить записи не меньше freshTTL + staleWhileRevalidate.
+func NewCachedBoardStructureReader(next BoardStructureReader, cache BoardCache, freshTTL, staleWhileRevalidate time.Duration) *CachedBoardStructureReader {
+	return &CachedBoardStructureReader{
+		next:                 next,
+		cache:                cache,
+		freshTTL:             freshTTL,
+		staleWhileRevalidate: staleWhileRevalidate,
+	}
+}
+
+func (r *CachedBoardStructureReader) Handle(ctx context.Context, id int64) (*board.Structure, error) {
+	cached, err := r.cache.Get(ctx, id)
+	if err != nil {
+		log.Warn().Err(err).Int64("board_id", id).Msg("board cache read failed, falling back to database")
+	} else if cached != nil {
+		age := time.Since(cached.StoredAt)
+
+		if age <= r.freshTTL {
+			return cached.Structure, nil
+		}
+
+		if age <= r.freshTTL+r.staleWhileRevalidate {
+			r.refreshInBackground(ctx, id)
+			return cached.Structure, nil
+		}
+	}
+
+	return r.loadShared(ctx, id)
+}
+
+// loadShared загружает доску из БД так, что на одну доску в каждый момент
+// идет не больше одного запроса. Сама загрузка не зависит от отмены контекста
+// первого вызвавшего, иначе его таймаут уронил бы всех ожидающих.
+func (r *CachedBoardStructureReader) loadShared(ctx context.Context, id int64) (*board.Structure, error) {
+	loadCtx := context.WithoutCancel(ctx)
+
+	ch := r.group.DoChan(strconv.FormatInt(id, 10), func() (any, error) {
+		return r.load(loadCtx, id)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*board.Structure), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *CachedBoardStructureReader) refreshInBackground(ctx context.Context, id int64) {
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+
+	// DoChan не блокирует: если обновление уже идет, просто присоединяемся к нему
+	ch := r.group.DoChan(strconv.FormatInt(id, 10), func() (any, error) {
+		return r.load(refreshCtx, id)
+	})
+
+	go func() {
+		defer cancel()
+
+		if res := <-ch; res.Err != nil {
+			log.Warn().Err(res.Err).Int64("board_id", id).Msg("background board cache refresh failed")
+		}
+	}()
+}
+
+// load читает доску из БД и кладет её в кэш. Поколение берется до чтения:
+// запись, параллельная чтению, сбросит кэш после коммита и сменит поколение,
+// и тогда Set отбросит уже устаревшую структуру вместо того, чтобы вернуть её в кэш
+func (r *CachedBoardStructureReader) load(ctx context.Context, id int64) (*board.Structure, error) {
+	generation, genErr := r.cache.Generation(ctx, id)
+	if genErr != nil {
+		log.Warn().Err(genErr).Int64("board_id", id).Msg("failed to read board cache generation")
+	}
+
+	structure, err := r.next.Handle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Без поколения нельзя проверить, что структура не устарела, поэтому не кэшируем
+	if genErr != nil {
+		return structure, nil
+	}
+
+	if err := r.cache.Set(ctx, structure, generation); err != nil {
+		log.Warn().Err(err).Int64("board_id", id).Msg("failed to cache board")
+	}
+
+	return structure, nil
+}
@@ -0,0 +1,194 @@
+package board
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// blockingReader — чтение из БД, которое ждет release. Версия прочитанной доски —
+// номер вызова, так по результату видно, из какой загрузки он пришел
+type blockingReader struct {
+	calls   atomic.Int64
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingReader() *blockingReader {
+	return &blockingReader{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (r *blockingReader) Handle(ctx context.Context, id int64) (*board.Structure, error) {
+	call := r.calls.Add(1)
+	r.started <- struct{}{}
+	<-r.release
+
+	return &board.Structure{Board: board.Board{ID: id, Version: call}}, nil
+}
+
+// waitStarted ждет, пока чтение из БД начнется
+func (r *blockingReader) waitStarted(t *testing.T) {
+	t.Helper()
+
+	select {
+	case <-r.started:
+	case <-time.After(time.Second):
+		t.Fatal("database read did not start")
+	}
+}
+
+// waitCached ждет, пока в кэше появится доска нужной версии
+func waitCached(t *testing.T, c *memoryCache, boardID, version int64) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cached, _ := c.Get(context.Background(), boardID); cached != nil && cached.Structure.Version == version {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("board %d version %d did not reach the cache", boardID, version)
+}
+
+const (
+	testFreshTTL = time.Minute
+	testStaleTTL = time.Minute
+)
+
+func TestCachedReaderReturnsFreshEntry(t *testing.T) {
+	next := newBlockingReader()
+	cache := newMemoryCache()
+	cache.put(&board.Structure{Board: board.Board{ID: 1, Version: 7}}, time.Now())
+
+	reader := NewCachedBoardStructureReader(next, cache, testFreshTTL, testStaleTTL)
+
+	structure, err := reader.Handle(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if structure.Version != 7 {
+		t.Fatalf("version = %d, want cached 7", structure.Version)
+	}
+	if calls := next.calls.Load(); calls != 0 {
+		t.Fatalf("database read %d times for a fresh entry", calls)
+	}
+}
+
+func TestCachedReaderCoalescesMisses(t *testing.T) {
+	next := newBlockingReader()
+	cache := newMemoryCache()
+	reader := NewCachedBoardStructureReader(next, cache, testFreshTTL, testStaleTTL)
+
+	const readers = 10
+
+	var wg sync.WaitGroup
+	versions := make([]int64, readers)
+	for i := range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			structure, err := reader.Handle(context.Background(), 1)
+			if err != nil {
+				t.Errorf("Handle: %v", err)
+				return
+			}
+			versions[i] = structure.Version
+		}()
+	}
+
+	next.waitStarted(t)
+	// Даем остальным читателям дойти до ожидания первой загрузки
+	time.Sleep(20 * time.Millisecond)
+	close(next.release)
+	wg.Wait()
+
+	if calls := next.calls.Load(); calls != 1 {
+		t.Fatalf("database read %d times for concurrent misses, want 1", calls)
+	}
+	for i, v := range versions {
+		if v != 1 {
+			t.Errorf("reader %d got version %d, want 1", i, v)
+		}
+	}
+	waitCached(t, cache, 1, 1)
+}
+
+func TestCachedReaderServesStaleWhileRevalidating(t *testing.T) {
+	next := newBlockingReader()
+	cache := newMemoryCache()
+	cache.put(&board.Structure{Board: board.Board{ID: 1, Version: 7}}, time.Now().Add(-testFreshTTL-time.Second))
+
+	reader := NewCachedBoardStructureReader(next, cache, testFreshTTL, testStaleTTL)
+
+	// Пока обновление висит на БД, устаревшая запись отдается сразу, а новые
+	// обращения не запускают второе обновление
+	for range 3 {
+		structure, err := reader.Handle(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Handle: %v", err)
+		}
+		if structure.Version != 7 {
+			t.Fatalf("version = %d, want stale 7", structure.Version)
+		}
+	}
+
+	next.waitStarted(t)
+	close(next.release)
+	waitCached(t, cache, 1, 1)
+
+	if calls := next.calls.Load(); calls != 1 {
+		t.Fatalf("database read %d times during revalidation, want 1", calls)
+	}
+}
+
+func TestCachedReaderLoadsExpiredEntry(t *testing.T) {
+	next := newBlockingReader()
+	close(next.release)
+
+	cache := newMemoryCache()
+	cache.put(&board.Structure{Board: board.Board{ID: 1, Version: 7}}, time.Now().Add(-testFreshTTL-testStaleTTL-time.Second))
+
+	reader := NewCachedBoardStructureReader(next, cache, testFreshTTL, testStaleTTL)
+
+	structure, err := reader.Handle(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if structure.Version != 1 {
+		t.Fatalf("version = %d, want 1 from database", structure.Version)
+	}
+}
+
+func TestCachedReaderDropsStructureReadBeforeInvalidation(t *testing.T) {
+	next := newBlockingReader()
+	cache := newMemoryCache()
+	reader := NewCachedBoardStructureReader(next, cache, testFreshTTL, testStaleTTL)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		if _, err := reader.Handle(context.Background(), 1); err != nil {
+			t.Errorf("Handle: %v", err)
+		}
+	}()
+
+	// Загрузка уже прочитала старую доску, а запись успела закоммитить изменение
+	// и сбросить кэш раньше, чем загрузка положила результат
+	next.waitStarted(t)
+	if err := cache.Invalidate(context.Background(), 1); err != nil {
+		t.Fatalf("Invalidate: %v", err)
+	}
+	close(next.release)
+	<-done
+
+	if cached, _ := cache.Get(context.Background(), 1); cached != nil {
+		t.Fatalf("structure read before invalidation was cached: version %d", cached.Structure.Version)
+	}
+}
@@ -0,0 +1,129 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// CloneBoardUseCase создает новую доску вызывающего пользователя — полную копию
+// исходной: название, описание, колонки и задачи в том же порядке.
+// Участники и исполнители задач не копируются: у копии свой владелец и своя команда.
+type CloneBoardUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	memberRepo board.MemberRepository
+	outbox     Outbox
+	auth       *Authorizer
+}
+
+func NewCloneBoardUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, memberRepo board.MemberRepository, outbox Outbox, auth *Authorizer) *CloneBoardUseCase {
+	return &CloneBoardUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, memberRepo: memberRepo, outbox: outbox, auth: auth}
+}
+
+func (uc *CloneBoardUseCase) Handle(ctx context.Context, cmd CloneBoardCommand) (*board.Board, error) {
+	var (
+		source  *board.Board
+		columns []*board.Column
+		tasks   []*board.Task
+	)
+
+	// Исходную доску читаем согласованным снимком без блокировки: копирование
+	// большой доски не должно останавливать её редактирование
+	err := uc.tx.WithinReadOnlyTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		source, err = uc.boardRepo.GetByID(ctx, cmd.SourceID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, source, board.PermissionRead); err != nil {
+			return err
+		}
+
+		columns, err = uc.columnRepo.ListByBoard(ctx, source.ID)
+		if err != nil {
+			return err
+		}
+
+		tasks, err = uc.taskRepo.ListByBoard(ctx, source.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	title := source.Title
+	if cmd.Title != nil {
+		title = *cmd.Title
+	}
+
+	clone, err := board.NewBoard(title, source.Description, cmd.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := createOwnedBoard(ctx, uc.boardRepo, uc.memberRepo, uc.outbox, clone); err != nil {
+			return err
+		}
+
+		if err := copyStructure(ctx, uc.columnRepo, uc.taskRepo, clone, columns, tasks); err != nil {
+			return err
+		}
+
+		// Одно событие на всю копию вместо событий о каждой колонке и задаче
+		return uc.outbox.Save(ctx, board.NewBoardCloned(clone, source.ID, len(columns), len(tasks)))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
+
+// copyStructure создает на доске target колонки и задачи по образцу.
+// Колонки и задачи должны идти в порядке позиций, как их отдает ListByBoard:
+// позиции в копии назначаются заново подряд с нуля
+func copyStructure(ctx context.Context, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, target *board.Board, columns []*board.Column, tasks []*board.Task) error {
+	// id исходной колонки -> созданная колонка
+	copies := make(map[int64]*board.Column, len(columns))
+
+	for i, c := range columns {
+		column, err := board.NewColumn(target.ID, c.Title, i)
+		if err != nil {
+			return err
+		}
+
+		if err := columnRepo.Create(ctx, column); err != nil {
+			return err
+		}
+
+		copies[c.ID] = column
+	}
+
+	positions := make(map[int64]int, len(columns))
+
+	for _, t := range tasks {
+		column, ok := copies[t.ColumnID]
+		if !ok {
+			return board.ErrColumnNotFound
+		}
+
+		task, err := board.NewTask(column.ID, t.Title, t.Description, 0, positions[column.ID])
+		if err != nil {
+			return err
+		}
+
+		if err := taskRepo.Create(ctx, task); err != nil {
+			return err
+		}
+
+		positions[column.ID]++
+	}
+
+	return nil
+}
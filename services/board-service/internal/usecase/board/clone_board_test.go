@@ -0,0 +1,187 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestCloneBoard(t *testing.T) {
+	renamed, empty := "Copy", ""
+
+	tests := []struct {
+		name   string
+		caller int64
+		title  *string
+		// deleted — исходная доска удалена
+		deleted bool
+		want    string
+		err     error
+	}{
+		{name: "owner", caller: ownerID, want: "Board"},
+		{name: "viewer", caller: viewerID, want: "Board"},
+		{name: "with new title", caller: editorID, title: &renamed, want: "Copy"},
+		{name: "with empty title", caller: ownerID, title: &empty, err: board.ErrTitleRequired},
+		{name: "not a member", caller: strangerID, err: board.ErrForbidden},
+		{name: "deleted board", caller: ownerID, deleted: true, err: board.ErrBoardNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			source := f.seedMembers(t)
+			todo := &board.Column{BoardID: source.ID, Title: "Todo", Position: 0}
+			if err := f.columns.Create(as(ownerID), todo); err != nil {
+				t.Fatalf("create column: %v", err)
+			}
+			for i, title := range []string{"a", "b"} {
+				// У задачи исходной доски есть исполнитель — в копию он не переходит
+				if err := f.tasks.Create(as(ownerID), &board.Task{ColumnID: todo.ID, Title: title, AssigneeID: editorID, Position: i}); err != nil {
+					t.Fatalf("create task: %v", err)
+				}
+			}
+			if err := f.columns.Create(as(ownerID), &board.Column{BoardID: source.ID, Title: "Done", Position: 1}); err != nil {
+				t.Fatalf("create column: %v", err)
+			}
+			if tt.deleted {
+				f.store.updateBoard(source.ID, func(b *board.Board) { _ = b.SoftDelete(time.Now()) })
+			}
+			f.store.events, f.store.locked = nil, nil
+
+			uc := NewCloneBoardUseCase(f.store, f.boards, f.columns, f.tasks, f.members, f.outbox, f.auth)
+			clone, err := uc.Handle(as(tt.caller), CloneBoardCommand{SourceID: source.ID, Title: tt.title, OwnerID: tt.caller})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			// Исходная доска читается снимком и не блокируется на время копирования
+			if slices.Contains(f.store.locked, source.ID) || f.store.readOnlyTx != 1 {
+				t.Errorf("locked boards = %v, read-only transactions = %d", f.store.locked, f.store.readOnlyTx)
+			}
+			if tt.err != nil {
+				if len(f.store.events) != 0 {
+					t.Errorf("events = %v after failed clone", f.store.eventTypes())
+				}
+				return
+			}
+
+			if clone.ID == source.ID || clone.Title != tt.want || clone.Owner != tt.caller {
+				t.Errorf("clone = %+v", clone)
+			}
+			if titles, _ := f.columnTitles(clone.ID); !slices.Equal(titles, []string{"Todo", "Done"}) {
+				t.Errorf("columns = %v, want [Todo Done]", titles)
+			}
+
+			copied, _ := f.columns.ListByBoard(as(tt.caller), clone.ID)
+			tasks := f.store.columnTasks(copied[0].ID)
+			if len(tasks) != 2 || tasks[0].Title != "a" || tasks[1].Title != "b" {
+				t.Fatalf("tasks = %+v, want a, b", tasks)
+			}
+			for _, task := range tasks {
+				if task.AssigneeID != 0 {
+					t.Errorf("task %q assignee = %d, want none", task.Title, task.AssigneeID)
+				}
+			}
+
+			// У копии своя команда: только тот, кто клонировал
+			members, _ := f.members.ListByBoard(as(tt.caller), clone.ID)
+			if len(members) != 1 || members[0].UserID != tt.caller || members[0].Role != board.RoleOwner {
+				t.Errorf("members = %+v, want only owner %d", members, tt.caller)
+			}
+
+			// Одно событие о копии, без событий о каждой колонке и задаче
+			if got := f.store.eventTypes(); !slices.Equal(got, []board.EventType{board.EventBoardCreated, board.EventBoardCloned}) {
+				t.Fatalf("events = %v", got)
+			}
+			payload := f.store.events[1].Payload.(board.BoardClonedPayload)
+			if payload.SourceBoardID != source.ID || payload.Columns != 2 || payload.Tasks != 2 {
+				t.Errorf("payload = %+v", payload)
+			}
+		})
+	}
+}
+
+func TestSetBoardTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		caller   int64
+		template bool
+		// already — доска уже отмечена шаблоном
+		already bool
+		err     error
+		version int64
+	}{
+		{name: "editor marks", caller: editorID, template: true, version: 2},
+		{name: "owner unmarks", caller: ownerID, already: true, template: false, version: 2},
+		{name: "repeated mark", caller: ownerID, already: true, template: true, version: 1},
+		{name: "viewer marks", caller: viewerID, template: true, err: board.ErrForbidden, version: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			f.store.updateBoard(b.ID, func(b *board.Board) { b.IsTemplate = tt.already })
+
+			uc := NewSetBoardTemplateUseCase(f.store, f.boards, f.outbox, f.cache, f.auth)
+			_, err := uc.Handle(as(tt.caller), SetBoardTemplateCommand{BoardID: b.ID, IsTemplate: tt.template})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			stored := f.store.boards[b.ID]
+			want := tt.template
+			if tt.err != nil {
+				want = tt.already
+			}
+			if stored.IsTemplate != want || stored.Version != tt.version {
+				t.Errorf("template = %v v%d, want %v v%d", stored.IsTemplate, stored.Version, want, tt.version)
+			}
+		})
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	f := newFixture()
+	template := f.seedMembers(t)
+	f.store.updateBoard(template.ID, func(b *board.Board) { b.IsTemplate = true })
+	f.seedBoard(ownerID, "Not a template", nil)
+	deleted, _ := f.seedBoard(ownerID, "Deleted template", nil)
+	f.store.updateBoard(deleted.ID, func(b *board.Board) {
+		b.IsTemplate = true
+		_ = b.SoftDelete(time.Now())
+	})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []int64
+		err  error
+	}{
+		{name: "owner", ctx: as(ownerID), want: []int64{template.ID}},
+		{name: "viewer", ctx: as(viewerID), want: []int64{template.ID}},
+		{name: "not a member", ctx: as(strangerID), want: nil},
+		{name: "anonymous", ctx: context.Background(), err: board.ErrForbidden},
+	}
+
+	uc := NewListTemplatesUseCase(f.boards)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := uc.Handle(tt.ctx)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			var got []int64
+			for _, b := range list {
+				got = append(got, b.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("templates = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// getBoardColumn возвращает колонку, только если она принадлежит указанной доске
+func getBoardColumn(ctx context.Context, repo board.ColumnRepository, boardID, columnID int64) (*board.Column, error) {
+	column, err := repo.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.BoardID != boardID {
+		return nil, board.ErrColumnNotFound
+	}
+
+	return column, nil
+}
@@ -0,0 +1,163 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestCreateColumnAppendsToEnd(t *testing.T) {
+	f := newFixture()
+	b, _ := f.seedBoard(1, "Board", nil, "A", "B")
+
+	uc := NewCreateColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+	column, err := uc.Handle(as(1), CreateColumnCommand{BoardID: b.ID, Title: "C"})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if column.Position != 2 {
+		t.Errorf("position = %d, want 2", column.Position)
+	}
+
+	got, dense := f.columnTitles(b.ID)
+	if !slices.Equal(got, []string{"A", "B", "C"}) || !dense {
+		t.Errorf("columns = %v (dense %v), want [A B C]", got, dense)
+	}
+	if events := f.store.eventTypes(); events[len(events)-1] != board.EventColumnCreated {
+		t.Errorf("last event = %s, want %s", events[len(events)-1], board.EventColumnCreated)
+	}
+}
+
+func TestMoveColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   int
+		position int
+		want     []string
+		err      error
+	}{
+		{name: "forward", column: 0, position: 2, want: []string{"B", "C", "A", "D"}},
+		{name: "backward", column: 3, position: 1, want: []string{"A", "D", "B", "C"}},
+		{name: "to the end", column: 1, position: 3, want: []string{"A", "C", "D", "B"}},
+		{name: "to the start", column: 2, position: 0, want: []string{"C", "A", "B", "D"}},
+		{name: "same position", column: 1, position: 1, want: []string{"A", "B", "C", "D"}},
+		{name: "past the end", column: 0, position: 4, err: board.ErrInvalidPosition},
+		{name: "negative position", column: 0, position: -1, err: board.ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(1, "Board", nil, "A", "B", "C", "D")
+
+			uc := NewMoveColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+			moved, err := uc.Handle(as(1), MoveColumnCommand{BoardID: b.ID, ColumnID: columns[tt.column].ID, Position: tt.position})
+
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				tt.want = []string{"A", "B", "C", "D"}
+			} else {
+				if err != nil {
+					t.Fatalf("Handle: %v", err)
+				}
+				if moved.Position != tt.position {
+					t.Errorf("position = %d, want %d", moved.Position, tt.position)
+				}
+			}
+
+			got, dense := f.columnTitles(b.ID)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+			if !dense {
+				t.Errorf("column positions are not 0..n-1")
+			}
+		})
+	}
+}
+
+func TestDeleteColumnClosesGap(t *testing.T) {
+	tests := []struct {
+		name   string
+		column int
+		want   []string
+	}{
+		{name: "first", column: 0, want: []string{"B", "C"}},
+		{name: "middle", column: 1, want: []string{"A", "C"}},
+		{name: "last", column: 2, want: []string{"A", "B"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(1, "Board", map[string][]string{"B": {"b0"}}, "A", "B", "C")
+
+			uc := NewDeleteColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+			if err := uc.Handle(as(1), DeleteColumnCommand{BoardID: b.ID, ColumnID: columns[tt.column].ID}); err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+
+			got, dense := f.columnTitles(b.ID)
+			if !slices.Equal(got, tt.want) || !dense {
+				t.Errorf("columns = %v (dense %v), want %v", got, dense, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnUseCasesErrors(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", nil, "A")
+	_, foreign := f.seedBoard(1, "Other", nil, "X")
+
+	f.store.ensureUser(2)
+	if err := f.members.Create(as(1), &board.Member{BoardID: b.ID, UserID: 2, Role: board.RoleViewer}); err != nil {
+		t.Fatalf("add viewer: %v", err)
+	}
+
+	rename := NewRenameColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+	create := NewCreateColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+	remove := NewDeleteColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth)
+
+	tests := []struct {
+		name string
+		call func() error
+		err  error
+	}{
+		{name: "rename to empty title", call: func() error {
+			_, err := rename.Handle(as(1), RenameColumnCommand{BoardID: b.ID, ColumnID: columns[0].ID})
+			return err
+		}, err: board.ErrColumnTitleRequired},
+		{name: "rename column of another board", call: func() error {
+			_, err := rename.Handle(as(1), RenameColumnCommand{BoardID: b.ID, ColumnID: foreign[0].ID, Title: "Y"})
+			return err
+		}, err: board.ErrColumnNotFound},
+		{name: "create by viewer", call: func() error {
+			_, err := create.Handle(as(2), CreateColumnCommand{BoardID: b.ID, Title: "B"})
+			return err
+		}, err: board.ErrForbidden},
+		{name: "create on missing board", call: func() error {
+			_, err := create.Handle(as(1), CreateColumnCommand{BoardID: 999, Title: "B"})
+			return err
+		}, err: board.ErrBoardNotFound},
+		{name: "delete column of another board", call: func() error {
+			return remove.Handle(as(1), DeleteColumnCommand{BoardID: b.ID, ColumnID: foreign[0].ID})
+		}, err: board.ErrColumnNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if got, _ := f.columnTitles(b.ID); !slices.Equal(got, []string{"A"}) {
+		t.Errorf("columns = %v after rejected commands, want [A]", got)
+	}
+}
@@ -7,11 +7,15 @@ import (
 )
 
 type CreateBoardUseCase struct {
-	repo board.Repository
+	tx         TxManager
+	repo       board.Repository
+	memberRepo board.MemberRepository
+	outbox     Outbox
+	idem       *Idempotency
 }
 
-func NewCreateBoardUseCase(repo board.Repository) *CreateBoardUseCase {
-	return &CreateBoardUseCase{repo: repo}
+func NewCreateBoardUseCase(tx TxManager, repo board.Repository, memberRepo board.MemberRepository, outbox Outbox, idem *Idempotency) *CreateBoardUseCase {
+	return &CreateBoardUseCase{tx: tx, repo: repo, memberRepo: memberRepo, outbox: outbox, idem: idem}
 }
 
 func (uc *CreateBoardUseCase) Handle(ctx context.Context, cmd CreateBoardCommand) (*board.Board, error) {
@@ -21,11 +25,43 @@ func (uc *CreateBoardUseCase) Handle(ctx context.Context, cmd CreateBoardCommand
 		return nil, err
 	}
 
-	// 2. Сохраняем через репозиторий
-	if err := uc.repo.Create(ctx, b); err != nil {
+	if err := board.ValidateIdempotencyKey(cmd.IdempotencyKey); err != nil {
+		return nil, err
+	}
+
+	// 2. Сохраняем через репозиторий вместе с событием — в одной транзакции.
+	// Повтор с тем же ключом вернет доску из первого запроса, не создавая новую
+	var created *board.Board
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err = runIdempotent(ctx, uc.idem, cmd.OwnerID, operationCreateBoard, cmd.IdempotencyKey, cmd, func(ctx context.Context) (*board.Board, error) {
+			return b, createOwnedBoard(ctx, uc.repo, uc.memberRepo, uc.outbox, b)
+		})
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// 3. Возвращаем созданную доску (у неё уже будет ID, проставленный репозиторием)
-	return b, nil
+	return created, nil
+}
+
+// createOwnedBoard сохраняет новую доску вместе со строкой владельца в board_members
+// и событием о создании. Вызывается внутри транзакции
+func createOwnedBoard(ctx context.Context, repo board.Repository, memberRepo board.MemberRepository, outbox Outbox, b *board.Board) error {
+	if err := repo.Create(ctx, b); err != nil {
+		return err
+	}
+
+	owner, err := board.NewMember(b.ID, b.Owner, board.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	if err := memberRepo.Create(ctx, owner); err != nil {
+		return err
+	}
+
+	return outbox.Save(ctx, board.NewBoardCreated(b))
 }
@@ -0,0 +1,60 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type CreateColumnUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewCreateColumnUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *CreateColumnUseCase {
+	return &CreateColumnUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle добавляет колонку в конец доски
+func (uc *CreateColumnUseCase) Handle(ctx context.Context, cmd CreateColumnCommand) (*board.Column, error) {
+	var column *board.Column
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Блокируем доску, чтобы параллельные операции не заняли ту же позицию
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		count, err := uc.columnRepo.CountByBoard(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		column, err = board.NewColumn(cmd.BoardID, cmd.Title, count)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.columnRepo.Create(ctx, column); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewColumnCreated(column))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return column, nil
+}
@@ -0,0 +1,79 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+type CreateInvitationUseCase struct {
+	tx             TxManager
+	boardRepo      board.Repository
+	invitationRepo board.InvitationRepository
+	tokens         InvitationTokens
+	mailer         InvitationMailer
+	auth           *Authorizer
+	ttl            time.Duration
+}
+
+// NewCreateInvitationUseCase: ttl — сколько приглашение можно принять после отправки
+func NewCreateInvitationUseCase(tx TxManager, boardRepo board.Repository, invitationRepo board.InvitationRepository, tokens InvitationTokens, mailer InvitationMailer, auth *Authorizer, ttl time.Duration) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{tx: tx, boardRepo: boardRepo, invitationRepo: invitationRepo, tokens: tokens, mailer: mailer, auth: auth, ttl: ttl}
+}
+
+// Handle приглашает email на доску с заданной ролью и отправляет письмо с токеном.
+// Повторное приглашение на тот же адрес отзывает предыдущее, действующим остается одно.
+func (uc *CreateInvitationUseCase) Handle(ctx context.Context, cmd CreateInvitationCommand) (*board.Invitation, error) {
+	role, err := board.ParseRole(cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	token, hash, err := uc.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	invitedBy, _ := identity.UserID(ctx)
+
+	invitation, err := board.NewInvitation(cmd.BoardID, cmd.Email, role, invitedBy, hash, uc.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		callerRole, err := managerRole(ctx, uc.auth, b)
+		if err != nil {
+			return err
+		}
+
+		if !callerRole.CanAssign(role) {
+			return board.ErrForbidden
+		}
+
+		if _, err := uc.invitationRepo.RevokePending(ctx, cmd.BoardID, invitation.Email, invitation.CreatedAt); err != nil {
+			return err
+		}
+
+		return uc.invitationRepo.Create(ctx, invitation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Письмо уходит после коммита: токен в нем должен уже существовать в БД.
+	// Если отправка не удалась, приглашение можно просто создать заново.
+	if err := uc.mailer.SendInvitation(ctx, invitation, token); err != nil {
+		return nil, fmt.Errorf("failed to send invitation: %w", err)
+	}
+
+	return invitation, nil
+}
@@ -0,0 +1,64 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type CreateTaskUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewCreateTaskUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *CreateTaskUseCase {
+	return &CreateTaskUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle добавляет задачу в конец колонки
+func (uc *CreateTaskUseCase) Handle(ctx context.Context, cmd CreateTaskCommand) (*board.Task, error) {
+	var task *board.Task
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		if _, err := getBoardColumn(ctx, uc.columnRepo, cmd.BoardID, cmd.ColumnID); err != nil {
+			return err
+		}
+
+		count, err := uc.taskRepo.CountByColumn(ctx, cmd.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		task, err = board.NewTask(cmd.ColumnID, cmd.Title, cmd.Description, cmd.AssigneeID, count)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.taskRepo.Create(ctx, task); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewTaskCreated(b, task))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return task, nil
+}
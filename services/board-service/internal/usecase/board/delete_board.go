@@ -2,23 +2,50 @@ package board
 
 import (
 	"context"
+	"time"
 
 	"Taskify/services/board-service/internal/domain/board"
 )
 
 type DeleteBoardUseCase struct {
-	repo board.Repository
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
 }
 
-func NewDeleteBoardUseCase(repo board.Repository) *DeleteBoardUseCase {
-	return &DeleteBoardUseCase{repo: repo}
+func NewDeleteBoardUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *DeleteBoardUseCase {
+	return &DeleteBoardUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
 }
 
+// Handle удаляет доску мягко: до очистки её можно вернуть через RestoreBoardUseCase
 func (uc *DeleteBoardUseCase) Handle(ctx context.Context, id int64) error {
-	err := uc.repo.Delete(ctx, id)
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.repo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionDelete); err != nil {
+			return err
+		}
+
+		if err := b.SoftDelete(time.Now()); err != nil {
+			return err
+		}
+
+		if _, err := uc.repo.Update(ctx, b); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardDeleted(id))
+	})
 	if err != nil {
 		return err
 	}
 
+	invalidateBoard(ctx, uc.cache, id)
+
 	return nil
 }
@@ -0,0 +1,61 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type DeleteColumnUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewDeleteColumnUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *DeleteColumnUseCase {
+	return &DeleteColumnUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *DeleteColumnUseCase) Handle(ctx context.Context, cmd DeleteColumnCommand) error {
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		column, err := getBoardColumn(ctx, uc.columnRepo, cmd.BoardID, cmd.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		count, err := uc.columnRepo.CountByBoard(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.columnRepo.Delete(ctx, column.ID); err != nil {
+			return err
+		}
+
+		// Закрываем дыру, оставшуюся после удаленной колонки
+		if err := uc.columnRepo.ShiftPositions(ctx, cmd.BoardID, column.Position+1, count-1, -1); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewColumnDeleted(column))
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type DeleteTaskUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewDeleteTaskUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *DeleteTaskUseCase {
+	return &DeleteTaskUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *DeleteTaskUseCase) Handle(ctx context.Context, cmd DeleteTaskCommand) error {
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		task, err := getBoardTask(ctx, uc.taskRepo, uc.columnRepo, cmd.BoardID, cmd.TaskID)
+		if err != nil {
+			return err
+		}
+
+		count, err := uc.taskRepo.CountByColumn(ctx, task.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.taskRepo.Delete(ctx, task.ID); err != nil {
+			return err
+		}
+
+		if err := uc.taskRepo.ShiftPositions(ctx, task.ColumnID, task.Position+1, count-1, -1); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewTaskDeleted(b, task))
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return nil
+}
@@ -1,17 +1,189 @@
 package board
 
+import (
+	"Taskify/services/board-service/internal/domain/board"
+)
+
 type CreateBoardCommand struct {
 	Title       string
 	Description string
 	OwnerID     int64
+	// Ключ идемпотентности от клиента; пустой — без защиты от повторов
+	IdempotencyKey string `json:"-"`
+}
+
+type ListBoardsQuery struct {
+	// 0 — размер по умолчанию, больше максимума — урезается до максимума
+	PageSize  int
+	PageToken string
+	// Подстрока названия
+	Search string
+	// active (по умолчанию), archived, all или deleted
+	Archived string
+	// created_at (по умолчанию) или updated_at
+	SortBy string
+	// desc (по умолчанию) или asc
+	Order string
+}
+
+type ListBoardsResult struct {
+	Boards []*board.Board
+	// Пустой на последней странице
+	NextPageToken string
 }
 
 type UpdateBoardCommand struct {
 	ID          int64
 	Title       *string
 	Description *string
+	// Версия, которую видел клиент; nil — обновить без проверки
+	ExpectedVersion *int64
 }
 
 type MoveBoardCommand struct {
+	ID    int64
 	Owner int64
+	// Кто инициировал передачу
+	MovedBy int64
+}
+
+type CloneBoardCommand struct {
+	// Доска или шаблон, который копируется
+	SourceID int64
+	// Название копии; nil — как у исходной доски
+	Title *string
+	// Владелец копии — тот, кто клонирует
+	OwnerID int64
+}
+
+type SetBoardTemplateCommand struct {
+	BoardID    int64
+	IsTemplate bool
+}
+
+type ExportBoardQuery struct {
+	BoardID int64
+	// json (по умолчанию), csv или markdown
+	Format string
+}
+
+type ImportTrelloBoardCommand struct {
+	// JSON экспорта доски из Trello
+	Export []byte
+	// Владелец новой доски — тот, кто импортирует
+	OwnerID int64
+}
+
+type ImportBoardCommand struct {
+	// JSON документ доски, выгруженный ExportBoard в формате json
+	Document []byte
+	// Владелец новой доски — тот, кто импортирует
+	OwnerID int64
+}
+
+// ImportIssue — элемент экспорта, который не удалось перенести
+type ImportIssue struct {
+	// board, list, card, member, checklist или task
+	Kind string
+	// id элемента в исходной системе
+	SourceID string
+	Name     string
+	Reason   string
+}
+
+// ImportReport — результат импорта: созданная доска, сколько перенесено
+// и что пропущено. Пропуски не прерывают импорт
+type ImportReport struct {
+	Board   *board.Board
+	Columns int
+	Tasks   int
+	// Сколько найденных пользователей приглашено на доску: участниками они
+	// становятся, только приняв приглашение
+	Invitations int
+	Issues      []ImportIssue
+}
+
+type CreateColumnCommand struct {
+	BoardID int64
+	Title   string
+}
+
+type RenameColumnCommand struct {
+	BoardID  int64
+	ColumnID int64
+	Title    string
+}
+
+type MoveColumnCommand struct {
+	BoardID  int64
+	ColumnID int64
+	Position int
+}
+
+type DeleteColumnCommand struct {
+	BoardID  int64
+	ColumnID int64
+}
+
+type CreateTaskCommand struct {
+	BoardID     int64
+	ColumnID    int64
+	Title       string
+	Description string
+	AssigneeID  int64
+}
+
+type GetTaskQuery struct {
+	BoardID int64
+	TaskID  int64
+}
+
+type UpdateTaskCommand struct {
+	BoardID     int64
+	TaskID      int64
+	Title       *string
+	Description *string
+	// 0 снимает исполнителя, nil оставляет как есть
+	AssigneeID *int64
+}
+
+type DeleteTaskCommand struct {
+	BoardID int64
+	TaskID  int64
+}
+
+type MoveTaskCommand struct {
+	BoardID int64
+	TaskID  int64
+	// Колонка назначения, может совпадать с текущей
+	ColumnID int64
+	Position int
+}
+
+type AddMemberCommand struct {
+	BoardID int64
+	UserID  int64
+	Role    string
+}
+
+type UpdateMemberRoleCommand struct {
+	BoardID int64
+	UserID  int64
+	Role    string
+}
+
+type RemoveMemberCommand struct {
+	BoardID int64
+	UserID  int64
+}
+
+type CreateInvitationCommand struct {
+	BoardID int64
+	Email   string
+	Role    string
+}
+
+type RevokeInvitationCommand struct {
+	BoardID      int64
+	InvitationID int64
 }
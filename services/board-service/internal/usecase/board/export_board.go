@@ -0,0 +1,145 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// DefaultExportFormat — формат выгрузки, если клиент его не указал
+const DefaultExportFormat = "json"
+
+// ExportWriter записывает снимок доски в одном формате
+type ExportWriter interface {
+	ContentType() string
+	// FileExtension — расширение файла выгрузки без точки
+	FileExtension() string
+	Write(w io.Writer, snapshot *board.Snapshot) error
+}
+
+// BoardExport — собранный снимок доски и формат, в котором его отдать.
+// Ошибки чтения и доступа возникают до первого записанного байта
+type BoardExport struct {
+	snapshot *board.Snapshot
+	writer   ExportWriter
+}
+
+func (e *BoardExport) ContentType() string {
+	return e.writer.ContentType()
+}
+
+func (e *BoardExport) FileName() string {
+	return fmt.Sprintf("board-%d.%s", e.snapshot.Structure.ID, e.writer.FileExtension())
+}
+
+func (e *BoardExport) Write(w io.Writer) error {
+	return e.writer.Write(w, e.snapshot)
+}
+
+// ExportBoardUseCase читает доску, колонки, задачи, участников и логины в одной
+// транзакции только на чтение: выгрузка — согласованный снимок, а не сборка
+// из кэшированной структуры и отдельно прочитанных участников
+type ExportBoardUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	memberRepo board.MemberRepository
+	userRepo   board.UserRepository
+	auth       *Authorizer
+	writers    map[string]ExportWriter
+}
+
+// NewExportBoardUseCase: writers — название формата -> writer
+func NewExportBoardUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, memberRepo board.MemberRepository, userRepo board.UserRepository, auth *Authorizer, writers map[string]ExportWriter) *ExportBoardUseCase {
+	return &ExportBoardUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, memberRepo: memberRepo, userRepo: userRepo, auth: auth, writers: writers}
+}
+
+func (uc *ExportBoardUseCase) Handle(ctx context.Context, q ExportBoardQuery) (*BoardExport, error) {
+	format := q.Format
+	if format == "" {
+		format = DefaultExportFormat
+	}
+
+	writer, ok := uc.writers[format]
+	if !ok {
+		return nil, board.ErrInvalidExportFormat
+	}
+
+	var snapshot *board.Snapshot
+
+	err := uc.tx.WithinReadOnlyTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByID(ctx, q.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionRead); err != nil {
+			return err
+		}
+
+		columns, err := uc.columnRepo.ListByBoard(ctx, q.BoardID)
+		if err != nil {
+			return err
+		}
+
+		tasks, err := uc.taskRepo.ListByBoard(ctx, q.BoardID)
+		if err != nil {
+			return err
+		}
+
+		members, err := uc.memberRepo.ListByBoard(ctx, q.BoardID)
+		if err != nil {
+			return err
+		}
+
+		structure := board.NewStructure(b, columns, tasks)
+
+		usernames, err := uc.userRepo.GetUsernames(ctx, referencedUsers(structure, members))
+		if err != nil {
+			return err
+		}
+
+		snapshot = &board.Snapshot{
+			Structure:  structure,
+			Members:    members,
+			Usernames:  usernames,
+			ExportedAt: time.Now(),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoardExport{snapshot: snapshot, writer: writer}, nil
+}
+
+// referencedUsers собирает id владельца, участников и исполнителей без повторов
+func referencedUsers(structure *board.Structure, members []*board.Member) []int64 {
+	seen := map[int64]bool{structure.Owner: true}
+	ids := []int64{structure.Owner}
+
+	add := func(id int64) {
+		if id != 0 && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, m := range members {
+		add(m.UserID)
+	}
+
+	for _, c := range structure.Columns {
+		for _, t := range c.Tasks {
+			add(t.AssigneeID)
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,113 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// snapshotWriter запоминает снимок, который ему передали
+type snapshotWriter struct {
+	snapshot *board.Snapshot
+}
+
+func (w *snapshotWriter) ContentType() string   { return "application/json" }
+func (w *snapshotWriter) FileExtension() string { return "json" }
+
+func (w *snapshotWriter) Write(_ io.Writer, snapshot *board.Snapshot) error {
+	w.snapshot = snapshot
+	return nil
+}
+
+func newExportUseCase(f *fixture, writer ExportWriter) *ExportBoardUseCase {
+	return NewExportBoardUseCase(f.store, f.boards, f.columns, f.tasks, f.members, f.users, f.auth, map[string]ExportWriter{"json": writer})
+}
+
+func TestExportBoardSnapshot(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", map[string][]string{
+		"Todo": {"First", "Second"},
+		"Done": {"Third"},
+	}, "Todo", "Done")
+
+	f.store.addUser(2, "viewer")
+	f.store.addUser(3, "assignee")
+	if err := f.members.Create(context.Background(), &board.Member{BoardID: b.ID, UserID: 2, Role: board.RoleViewer}); err != nil {
+		t.Fatalf("add member: %v", err)
+	}
+
+	task := f.store.columnTasks(columns[1].ID)[0]
+	task.AssigneeID = 3
+	if _, err := f.tasks.Update(context.Background(), &task); err != nil {
+		t.Fatalf("assign task: %v", err)
+	}
+
+	// В кэше лежит устаревшая структура: выгрузка не должна её использовать
+	f.cache.put(&board.Structure{Board: board.Board{ID: b.ID, Title: "Stale"}}, time.Now())
+
+	writer := &snapshotWriter{}
+	export, err := newExportUseCase(f, writer).Handle(as(2), ExportBoardQuery{BoardID: b.ID})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if err := export.Write(io.Discard); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if f.store.readOnlyTx != 1 {
+		t.Fatalf("read-only transactions = %d, want 1", f.store.readOnlyTx)
+	}
+
+	s := writer.snapshot
+	if s.Structure.Title != "Board" {
+		t.Errorf("title = %q, want Board from the database", s.Structure.Title)
+	}
+	if len(s.Structure.Columns) != 2 || len(s.Structure.Columns[0].Tasks) != 2 || len(s.Structure.Columns[1].Tasks) != 1 {
+		t.Fatalf("columns = %+v, want Todo with 2 tasks and Done with 1", s.Structure.Columns)
+	}
+	if len(s.Members) != 2 {
+		t.Errorf("members = %d, want owner and viewer", len(s.Members))
+	}
+
+	wantUsernames := map[int64]string{1: "user1", 2: "viewer", 3: "assignee"}
+	for id, name := range wantUsernames {
+		if s.Usernames[id] != name {
+			t.Errorf("username of %d = %q, want %q", id, s.Usernames[id], name)
+		}
+	}
+
+	if name := export.FileName(); name != "board-1.json" {
+		t.Errorf("file name = %q, want board-1.json", name)
+	}
+}
+
+func TestExportBoardErrors(t *testing.T) {
+	f := newFixture()
+	b, _ := f.seedBoard(1, "Board", nil)
+	f.store.addUser(2, "stranger")
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		query  ExportBoardQuery
+		target error
+	}{
+		{name: "unknown format", ctx: as(1), query: ExportBoardQuery{BoardID: b.ID, Format: "xml"}, target: board.ErrInvalidExportFormat},
+		{name: "not a member", ctx: as(2), query: ExportBoardQuery{BoardID: b.ID}, target: board.ErrForbidden},
+		{name: "anonymous", ctx: context.Background(), query: ExportBoardQuery{BoardID: b.ID}, target: board.ErrForbidden},
+		{name: "missing board", ctx: as(1), query: ExportBoardQuery{BoardID: 999}, target: board.ErrBoardNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newExportUseCase(f, &snapshotWriter{}).Handle(tt.ctx, tt.query)
+			if !errors.Is(err, tt.target) {
+				t.Fatalf("err = %v, want %v", err, tt.target)
+			}
+		})
+	}
+}
@@ -3,22 +3,42 @@ package board
 import (
 	"context"
 
+	"github.com/rs/zerolog/log"
+
 	"Taskify/services/board-service/internal/domain/board"
 )
 
 type GetBoardUseCase struct {
-	repo board.Repository
+	repo  board.Repository
+	cache BoardCache
+	auth  *Authorizer
 }
 
-func NewGetBoardUseCase(repo board.Repository) *GetBoardUseCase {
-	return &GetBoardUseCase{repo: repo}
+func NewGetBoardUseCase(repo board.Repository, cache BoardCache, auth *Authorizer) *GetBoardUseCase {
+	return &GetBoardUseCase{repo: repo, cache: cache, auth: auth}
 }
 
 func (uc *GetBoardUseCase) Handle(ctx context.Context, id int64) (*board.Board, error) {
+	// Сначала пробуем кэш: там лежит структура доски вместе с самой доской
+	cached, err := uc.cache.Get(ctx, id)
+	if err != nil {
+		log.Warn().Err(err).Int64("board_id", id).Msg("board cache read failed, falling back to database")
+	} else if cached != nil {
+		if err := uc.auth.Authorize(ctx, &cached.Structure.Board, board.PermissionRead); err != nil {
+			return nil, err
+		}
+
+		return &cached.Structure.Board, nil
+	}
+
 	receivedBoard, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := uc.auth.Authorize(ctx, receivedBoard, board.PermissionRead); err != nil {
+		return nil, err
+	}
+
 	return receivedBoard, nil
 }
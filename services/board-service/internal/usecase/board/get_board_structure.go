@@ -0,0 +1,39 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type GetBoardStructureUseCase struct {
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+}
+
+func NewGetBoardStructureUseCase(boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository) *GetBoardStructureUseCase {
+	return &GetBoardStructureUseCase{boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo}
+}
+
+// Handle собирает доску с колонками и задачами ровно за три запроса,
+// независимо от количества колонок
+func (uc *GetBoardStructureUseCase) Handle(ctx context.Context, id int64) (*board.Structure, error) {
+	b, err := uc.boardRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := uc.columnRepo.ListByBoard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Если колонку удалили между запросами, её задачи просто не попадут в результат
+	tasks, err := uc.taskRepo.ListByBoard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return board.NewStructure(b, columns, tasks), nil
+}
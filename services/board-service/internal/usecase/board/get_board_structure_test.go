@@ -0,0 +1,66 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestGetBoardStructure(t *testing.T) {
+	f := newFixture()
+	b, _ := f.seedBoard(1, "Board", map[string][]string{
+		"Todo": {"a", "b"},
+		"Done": {"c"},
+	}, "Todo", "Empty", "Done")
+	deleted, _ := f.seedBoard(1, "Deleted", nil)
+	f.store.updateBoard(deleted.ID, func(b *board.Board) { _ = b.SoftDelete(time.Now()) })
+
+	tests := []struct {
+		name    string
+		boardID int64
+		want    map[string][]string
+		order   []string
+		err     error
+	}{
+		{
+			name:    "columns and tasks by position",
+			boardID: b.ID,
+			order:   []string{"Todo", "Empty", "Done"},
+			want:    map[string][]string{"Todo": {"a", "b"}, "Empty": {}, "Done": {"c"}},
+		},
+		{name: "missing board", boardID: 999, err: board.ErrBoardNotFound},
+		{name: "deleted board", boardID: deleted.ID, err: board.ErrBoardNotFound},
+	}
+
+	uc := NewGetBoardStructureUseCase(f.boards, f.columns, f.tasks)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := uc.Handle(as(1), tt.boardID)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			var order []string
+			for _, c := range s.Columns {
+				order = append(order, c.Title)
+
+				titles := make([]string, 0, len(c.Tasks))
+				for _, task := range c.Tasks {
+					titles = append(titles, task.Title)
+				}
+				if !slices.Equal(titles, tt.want[c.Title]) {
+					t.Errorf("column %s = %v, want %v", c.Title, titles, tt.want[c.Title])
+				}
+			}
+			if !slices.Equal(order, tt.order) {
+				t.Errorf("columns = %v, want %v", order, tt.order)
+			}
+		})
+	}
+}
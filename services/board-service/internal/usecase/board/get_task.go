@@ -0,0 +1,31 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type GetTaskUseCase struct {
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	auth       *Authorizer
+}
+
+func NewGetTaskUseCase(boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, auth *Authorizer) *GetTaskUseCase {
+	return &GetTaskUseCase{boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, auth: auth}
+}
+
+func (uc *GetTaskUseCase) Handle(ctx context.Context, query GetTaskQuery) (*board.Task, error) {
+	b, err := uc.boardRepo.GetByID(ctx, query.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.auth.Authorize(ctx, b, board.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return getBoardTask(ctx, uc.taskRepo, uc.columnRepo, query.BoardID, query.TaskID)
+}
@@ -0,0 +1,122 @@
+package board
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// Операции, для которых запоминается ответ
+const operationCreateBoard = "create_board"
+
+// Idempotency запоминает ответы операций создания по ключу клиента,
+// чтобы повтор запроса (таймаут, обрыв соединения) не создал дубликат.
+type Idempotency struct {
+	repo   board.IdempotencyRepository
+	window time.Duration
+}
+
+func NewIdempotency(repo board.IdempotencyRepository, window time.Duration) *Idempotency {
+	return &Idempotency{repo: repo, window: window}
+}
+
+// runIdempotent выполняет create один раз на ключ. Вызывается внутри транзакции:
+// ключ резервируется в той же транзакции, что и запись, поэтому параллельный повтор
+// ждет на блокировке строки ключа и после коммита получает сохраненный ответ,
+// а после отката первого запроса выполняется сам.
+func runIdempotent[T any](ctx context.Context, idem *Idempotency, userID int64, operation, key string, request any, create func(ctx context.Context) (*T, error)) (*T, error) {
+	if key == "" {
+		return create(ctx)
+	}
+
+	hash, err := requestHash(request)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := idem.repo.Reserve(ctx, &board.IdempotencyRecord{
+		UserID:      userID,
+		Operation:   operation,
+		Key:         key,
+		RequestHash: hash,
+		ExpiresAt:   time.Now().Add(idem.window),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !reserved {
+		rec, err := idem.repo.Get(ctx, userID, operation, key)
+		if err != nil {
+			return nil, err
+		}
+
+		// Тот же ключ с другим телом — скорее всего ошибка клиента, а не повтор
+		if rec.RequestHash != hash || rec.Response == nil {
+			return nil, board.ErrIdempotencyKeyReused
+		}
+
+		var result T
+		if err := json.Unmarshal(rec.Response, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode idempotent response: %w", err)
+		}
+
+		return &result, nil
+	}
+
+	result, err := create(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode idempotent response: %w", err)
+	}
+
+	if err := idem.repo.SaveResponse(ctx, userID, operation, key, response); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RunPurge периодически удаляет истекшие ключи, пока не отменен ctx
+func (i *Idempotency) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := i.repo.DeleteExpired(ctx, time.Now())
+			if err != nil {
+				log.Error().Err(err).Msg("failed to purge expired idempotency keys")
+				continue
+			}
+			if deleted > 0 {
+				log.Debug().Int64("deleted", deleted).Msg("expired idempotency keys purged")
+			}
+		}
+	}
+}
+
+func requestHash(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode idempotent request: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,175 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestCreateBoardIdempotency(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  CreateBoardCommand
+		second CreateBoardCommand
+		// prepare выполняется между запросами
+		prepare func(f *fixture)
+		err     error
+		// same — второй запрос вернул доску первого
+		same   bool
+		boards int
+	}{
+		{
+			name:   "without key",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID},
+			second: CreateBoardCommand{Title: "Board", OwnerID: ownerID},
+			boards: 2,
+		},
+		{
+			name:   "repeated request",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			second: CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			same:   true,
+			boards: 1,
+		},
+		{
+			name:   "another key",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			second: CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-2"},
+			boards: 2,
+		},
+		{
+			name:   "same key with another body",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			second: CreateBoardCommand{Title: "Other", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			err:    board.ErrIdempotencyKeyReused,
+			boards: 1,
+		},
+		{
+			// Ключи разных пользователей не пересекаются
+			name:   "same key of another user",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			second: CreateBoardCommand{Title: "Board", OwnerID: newOwnerID, IdempotencyKey: "key-1"},
+			boards: 2,
+		},
+		{
+			name:   "expired key",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			second: CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"},
+			prepare: func(f *fixture) {
+				for key, rec := range f.store.idempotency {
+					rec.ExpiresAt = time.Now().Add(-time.Second)
+					f.store.idempotency[key] = rec
+				}
+			},
+			boards: 2,
+		},
+		{
+			name:   "key too long",
+			first:  CreateBoardCommand{Title: "Board", OwnerID: ownerID},
+			second: CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: strings.Repeat("k", board.MaxIdempotencyKeyLength+1)},
+			err:    board.ErrInvalidIdempotencyKey,
+			boards: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.store.ensureUser(ownerID)
+			f.store.ensureUser(newOwnerID)
+			uc := NewCreateBoardUseCase(f.store, f.boards, f.members, f.outbox, NewIdempotency(memoryIdempotency{f.store}, time.Hour))
+
+			first, err := uc.Handle(as(tt.first.OwnerID), tt.first)
+			if err != nil {
+				t.Fatalf("first request: %v", err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+
+			second, err := uc.Handle(as(tt.second.OwnerID), tt.second)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(f.store.boards) != tt.boards {
+				t.Errorf("boards = %d, want %d", len(f.store.boards), tt.boards)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if same := second.ID == first.ID; same != tt.same {
+				t.Errorf("second board %d, first %d, want same %v", second.ID, first.ID, tt.same)
+			}
+			if tt.same && (second.Title != first.Title || second.Owner != first.Owner) {
+				t.Errorf("replayed board = %+v, want %+v", second, first)
+			}
+		})
+	}
+}
+
+// Ключ резервируется в транзакции создания: если она откатилась, повтор выполнится заново
+func TestCreateBoardIdempotencyAfterFailure(t *testing.T) {
+	f := newFixture()
+	uc := NewCreateBoardUseCase(f.store, f.boards, f.members, f.outbox, NewIdempotency(memoryIdempotency{f.store}, time.Hour))
+	cmd := CreateBoardCommand{Title: "Board", OwnerID: ownerID, IdempotencyKey: "key-1"}
+
+	// Владельца еще нет — строка участника не создастся
+	if _, err := uc.Handle(as(ownerID), cmd); !errors.Is(err, board.ErrUserNotFound) {
+		t.Fatalf("first request err = %v, want %v", err, board.ErrUserNotFound)
+	}
+	if len(f.store.idempotency) != 0 {
+		t.Fatalf("keys = %v after rollback", f.store.idempotency)
+	}
+
+	f.store.ensureUser(ownerID)
+	created, err := uc.Handle(as(ownerID), cmd)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if len(f.store.boards) != 1 || created.ID == 0 {
+		t.Errorf("boards = %d, created = %+v", len(f.store.boards), created)
+	}
+}
+
+func TestIdempotencyPurge(t *testing.T) {
+	f := newFixture()
+	repo := memoryIdempotency{f.store}
+	for key, expiresAt := range map[string]time.Time{"old": time.Now().Add(-time.Minute), "fresh": time.Now().Add(time.Hour)} {
+		if _, err := repo.Reserve(context.Background(), &board.IdempotencyRecord{UserID: ownerID, Operation: operationCreateBoard, Key: key, ExpiresAt: expiresAt}); err != nil {
+			t.Fatalf("reserve: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewIdempotency(repo, time.Hour).RunPurge(ctx, time.Millisecond)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		f.store.mu.Lock()
+		left := len(f.store.idempotency)
+		f.store.mu.Unlock()
+		if left == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("keys left = %d, want 1", left)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	if _, err := repo.Get(context.Background(), ownerID, operationCreateBoard, "fresh"); err != nil {
+		t.Errorf("fresh key: %v", err)
+	}
+}
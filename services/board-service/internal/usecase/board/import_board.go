@@ -0,0 +1,227 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+const importIssueTask = "task"
+
+// importSourceTaskify — источник доски в событии BoardImported
+const importSourceTaskify = "taskify"
+
+// BoardDocumentReader разбирает выгрузку доски в формате json обратно в снимок.
+// id в снимке — id исходного окружения
+type BoardDocumentReader interface {
+	Read(data []byte) (*board.Snapshot, error)
+}
+
+// ImportBoardUseCase воссоздает доску из документа ExportBoard в любом окружении:
+// колонки и задачи получают новые id, пользователи сопоставляются по логину.
+// Структура доски переносится целиком или не переносится вовсе. Участники исходной
+// доски получают приглашения с прежними ролями, а пользователи, которых нет
+// в этом окружении, попадают в отчет
+type ImportBoardUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	memberRepo board.MemberRepository
+	userRepo   board.UserRepository
+	outbox     Outbox
+	reader     BoardDocumentReader
+	inviter    *ImportInviter
+}
+
+func NewImportBoardUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, memberRepo board.MemberRepository, userRepo board.UserRepository, outbox Outbox, reader BoardDocumentReader, inviter *ImportInviter) *ImportBoardUseCase {
+	return &ImportBoardUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, memberRepo: memberRepo, userRepo: userRepo, outbox: outbox, reader: reader, inviter: inviter}
+}
+
+func (uc *ImportBoardUseCase) Handle(ctx context.Context, cmd ImportBoardCommand) (*ImportReport, error) {
+	snapshot, err := uc.reader.Read(cmd.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	source := snapshot.Structure.Board
+
+	b, err := board.NewBoard(source.Title, source.Description, cmd.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Состояние доски восстанавливаем как было, версия же начинается заново
+	b.IsTemplate = source.IsTemplate
+	b.ArchivedAt = source.ArchivedAt
+	b.CreatedAt = importedTime(source.CreatedAt, b.CreatedAt)
+	b.UpdatedAt = importedTime(source.UpdatedAt, b.UpdatedAt)
+
+	report := &ImportReport{Issues: make([]ImportIssue, 0)}
+
+	var invitations []*importInvitation
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		users, err := uc.mapUsers(ctx, snapshot.Usernames)
+		if err != nil {
+			return err
+		}
+
+		if err := createOwnedBoard(ctx, uc.boardRepo, uc.memberRepo, uc.outbox, b); err != nil {
+			return err
+		}
+
+		invited, err := uc.inviteMembers(ctx, b, snapshot, users, report)
+		if err != nil {
+			return err
+		}
+		invitations = invited
+
+		if err := uc.importColumns(ctx, b, snapshot, users, report); err != nil {
+			return err
+		}
+
+		// Одно событие на весь импорт вместо событий о каждой колонке и задаче
+		return uc.outbox.Save(ctx, board.NewBoardImported(b, importSourceTaskify, report.Columns, report.Tasks))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.inviter.send(ctx, invitations, report)
+
+	report.Board = b
+
+	return report, nil
+}
+
+// mapUsers находит пользователей этого окружения с теми же логинами.
+// Возвращает id в исходном окружении -> id здесь
+func (uc *ImportBoardUseCase) mapUsers(ctx context.Context, usernames map[int64]string) (map[int64]int64, error) {
+	names := make([]string, 0, len(usernames))
+	for _, username := range usernames {
+		names = append(names, username)
+	}
+
+	found, err := uc.userRepo.FindIDsByUsernames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[int64]int64, len(found))
+	for sourceID, username := range usernames {
+		if userID, ok := found[strings.ToLower(username)]; ok {
+			users[sourceID] = userID
+		}
+	}
+
+	return users, nil
+}
+
+// inviteMembers приглашает участников исходной доски с их ролями. Владелец новой доски —
+// импортирующий, поэтому бывшего владельца приглашают админом. Участником человек
+// становится, только приняв приглашение.
+// На архивную доску приглашение не принять, а токен из письма может истечь раньше,
+// чем доску вернут из архива, поэтому для неё приглашения не создаются
+func (uc *ImportBoardUseCase) inviteMembers(ctx context.Context, b *board.Board, snapshot *board.Snapshot, users map[int64]int64, report *ImportReport) ([]*importInvitation, error) {
+	if b.IsArchived() {
+		if len(snapshot.Members) > 0 {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueBoard, SourceID: strconv.FormatInt(snapshot.Structure.ID, 10), Name: b.Title, Reason: "board is archived, members were not invited: invite them after unarchiving"})
+		}
+		return nil, nil
+	}
+
+	invitations := make([]*importInvitation, 0, len(snapshot.Members))
+
+	for _, m := range snapshot.Members {
+		username := snapshot.Usernames[m.UserID]
+
+		userID, ok := users[m.UserID]
+		if !ok {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueMember, SourceID: strconv.FormatInt(m.UserID, 10), Name: username, Reason: "no Taskify user with this username"})
+			continue
+		}
+
+		if userID == b.Owner {
+			continue
+		}
+
+		role, err := board.ParseRole(string(m.Role))
+		if err != nil {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueMember, SourceID: strconv.FormatInt(m.UserID, 10), Name: username, Reason: err.Error()})
+			continue
+		}
+
+		if role == board.RoleOwner {
+			role = board.RoleAdmin
+		}
+
+		invitation, err := uc.inviter.invite(ctx, b, userID, role, strconv.FormatInt(m.UserID, 10), username)
+		if err != nil {
+			return nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, nil
+}
+
+// importColumns создает колонки и их задачи под новыми id, сохраняя порядок и даты.
+// Невалидная колонка или задача означает испорченный документ и отменяет импорт
+func (uc *ImportBoardUseCase) importColumns(ctx context.Context, b *board.Board, snapshot *board.Snapshot, users map[int64]int64, report *ImportReport) error {
+	for i, c := range snapshot.Structure.Columns {
+		column, err := board.NewColumn(b.ID, c.Title, i)
+		if err != nil {
+			return fmt.Errorf("%w: column %d: %v", board.ErrInvalidImport, c.ID, err)
+		}
+
+		column.CreatedAt = importedTime(c.CreatedAt, column.CreatedAt)
+		column.UpdatedAt = importedTime(c.UpdatedAt, column.UpdatedAt)
+
+		if err := uc.columnRepo.Create(ctx, column); err != nil {
+			return err
+		}
+
+		report.Columns++
+
+		for j, t := range c.Tasks {
+			var assigneeID int64
+			if t.AssigneeID != 0 {
+				userID, ok := users[t.AssigneeID]
+				if !ok {
+					report.Issues = append(report.Issues, ImportIssue{Kind: importIssueTask, SourceID: strconv.FormatInt(t.ID, 10), Name: t.Title, Reason: "assignee " + snapshot.Usernames[t.AssigneeID] + " not found, task left unassigned"})
+				}
+				assigneeID = userID
+			}
+
+			task, err := board.NewTask(column.ID, t.Title, t.Description, assigneeID, j)
+			if err != nil {
+				return fmt.Errorf("%w: task %d: %v", board.ErrInvalidImport, t.ID, err)
+			}
+
+			task.CreatedAt = importedTime(t.CreatedAt, task.CreatedAt)
+			task.UpdatedAt = importedTime(t.UpdatedAt, task.UpdatedAt)
+
+			if err := uc.taskRepo.Create(ctx, task); err != nil {
+				return err
+			}
+
+			report.Tasks++
+		}
+	}
+
+	return nil
+}
+
+// importedTime берет дату из документа, а если её там нет — fallback
+func importedTime(t, fallback time.Time) time.Time {
+	if t.IsZero() {
+		return fallback
+	}
+	return t
+}
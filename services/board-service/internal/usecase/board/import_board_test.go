@@ -0,0 +1,202 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// snapshotReader отдает заранее собранный снимок вместо разбора документа
+type snapshotReader struct {
+	snapshot *board.Snapshot
+	err      error
+}
+
+func (r *snapshotReader) Read([]byte) (*board.Snapshot, error) {
+	return r.snapshot, r.err
+}
+
+func newImportUseCase(f *fixture, reader BoardDocumentReader, mailer InvitationMailer) *ImportBoardUseCase {
+	return NewImportBoardUseCase(f.store, f.boards, f.columns, f.tasks, f.members, f.users, f.outbox, reader, NewImportInviter(f.invitations, f.users, &seqTokens{}, mailer, time.Hour))
+}
+
+// importSnapshot — доска из другого окружения: id там свои, совпадают только логины.
+// alice (10) — импортирующий, dave (40) — прежний владелец, ghost (30) здесь нет
+func importSnapshot() *board.Snapshot {
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+
+	return &board.Snapshot{
+		Structure: &board.Structure{
+			Board: board.Board{ID: 100, Title: "Release", Description: "Q2", Owner: 40, Version: 17, IsTemplate: true, CreatedAt: created, UpdatedAt: updated},
+			Columns: []board.ColumnStructure{
+				{
+					Column: board.Column{ID: 200, Title: "Todo", Position: 3, CreatedAt: created},
+					Tasks: []*board.Task{
+						{ID: 300, Title: "mine", AssigneeID: 10, Position: 5},
+						{ID: 301, Title: "ghost's", AssigneeID: 30, Position: 9},
+						{ID: 302, Title: "nobody's", Position: 12},
+					},
+				},
+				{Column: board.Column{ID: 201, Title: "Done", Position: 7}},
+			},
+		},
+		Members: []*board.Member{
+			{UserID: 40, Role: board.RoleOwner},
+			{UserID: 10, Role: board.RoleAdmin},
+			{UserID: 20, Role: board.RoleEditor},
+			{UserID: 30, Role: board.RoleViewer},
+			{UserID: 50, Role: "superuser"},
+		},
+		Usernames: map[int64]string{10: "alice", 20: "Bob", 30: "ghost", 40: "dave", 50: "eve"},
+	}
+}
+
+func TestImportBoard(t *testing.T) {
+	f := newFixture()
+	f.store.addUser(ownerID, "alice")
+	f.store.addUser(2, "bob")
+	f.store.addUser(4, "dave")
+	f.store.addUser(5, "eve")
+	mailer := &memoryMailer{}
+
+	report, err := newImportUseCase(f, &snapshotReader{snapshot: importSnapshot()}, mailer).Handle(as(ownerID), ImportBoardCommand{OwnerID: ownerID})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	b := report.Board
+	source := importSnapshot().Structure.Board
+	// Id и версия новые, состояние и даты — как в документе
+	if b.ID == source.ID || b.Owner != ownerID || b.Version != 1 || !b.IsTemplate || b.IsArchived() || !b.CreatedAt.Equal(source.CreatedAt) {
+		t.Errorf("board = %+v", b)
+	}
+	if report.Columns != 2 || report.Tasks != 3 || report.Invitations != 2 {
+		t.Errorf("report = %d columns, %d tasks, %d invitations, want 2, 3, 2", report.Columns, report.Tasks, report.Invitations)
+	}
+
+	if titles, _ := f.columnTitles(b.ID); !slices.Equal(titles, []string{"Todo", "Done"}) {
+		t.Errorf("columns = %v, want [Todo Done]", titles)
+	}
+	columns, _ := f.columns.ListByBoard(as(ownerID), b.ID)
+	if columns[0].Position != 0 || columns[1].Position != 1 {
+		t.Errorf("positions = %d, %d, want 0, 1", columns[0].Position, columns[1].Position)
+	}
+	tasks := f.store.columnTasks(columns[0].ID)
+	if len(tasks) != 3 {
+		t.Fatalf("tasks = %+v", tasks)
+	}
+	// Исполнители сопоставлены по логину, неизвестный снят с задачи
+	for i, want := range []int64{ownerID, 0, 0} {
+		if tasks[i].AssigneeID != want || tasks[i].Position != i {
+			t.Errorf("task %q assignee = %d at %d, want %d at %d", tasks[i].Title, tasks[i].AssigneeID, tasks[i].Position, want, i)
+		}
+	}
+
+	// Прежний владелец приглашен админом, импортирующий — владелец без приглашения
+	roles := map[string]board.Role{}
+	for _, inv := range f.store.pendingInvitations(b.ID) {
+		roles[inv.Email] = inv.Role
+	}
+	if len(roles) != 2 || roles["dave@example.com"] != board.RoleAdmin || roles["bob@example.com"] != board.RoleEditor || len(mailer.sent) != 2 {
+		t.Errorf("invitations = %v, letters = %v", roles, mailer.sent)
+	}
+	if members, _ := f.members.ListByBoard(as(ownerID), b.ID); len(members) != 1 {
+		t.Errorf("members = %+v, want only the owner", members)
+	}
+
+	var issues []string
+	for _, issue := range report.Issues {
+		issues = append(issues, issue.Kind+":"+issue.SourceID)
+	}
+	if want := []string{"member:30", "member:50", "task:301"}; !slices.Equal(issues, want) {
+		t.Errorf("issues = %v, want %v", issues, want)
+	}
+
+	if got := f.store.eventTypes(); !slices.Equal(got, []board.EventType{board.EventBoardCreated, board.EventBoardImported}) {
+		t.Errorf("events = %v", got)
+	}
+}
+
+// Архивная доска импортируется архивной, но без приглашений: принять их нельзя
+// до разархивации, а токены в письмах могут к тому времени истечь
+func TestImportArchivedBoard(t *testing.T) {
+	f := newFixture()
+	f.store.addUser(ownerID, "alice")
+	f.store.addUser(2, "bob")
+	f.store.addUser(4, "dave")
+	mailer := &memoryMailer{}
+
+	snapshot := importSnapshot()
+	archived := snapshot.Structure.UpdatedAt
+	snapshot.Structure.ArchivedAt = &archived
+
+	report, err := newImportUseCase(f, &snapshotReader{snapshot: snapshot}, mailer).Handle(as(ownerID), ImportBoardCommand{OwnerID: ownerID})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	b := report.Board
+	if !b.IsArchived() || !b.ArchivedAt.Equal(archived) {
+		t.Errorf("archived at = %v, want %v", b.ArchivedAt, archived)
+	}
+	if report.Columns != 2 || report.Tasks != 3 || report.Invitations != 0 {
+		t.Errorf("report = %d columns, %d tasks, %d invitations, want 2, 3, 0", report.Columns, report.Tasks, report.Invitations)
+	}
+	if pending := f.store.pendingInvitations(b.ID); len(pending) != 0 || len(mailer.sent) != 0 {
+		t.Errorf("invitations = %+v, letters = %v", pending, mailer.sent)
+	}
+
+	var issues []string
+	for _, issue := range report.Issues {
+		issues = append(issues, issue.Kind+":"+issue.SourceID)
+	}
+	if want := []string{"board:100", "task:301"}; !slices.Equal(issues, want) {
+		t.Errorf("issues = %v, want %v", issues, want)
+	}
+}
+
+func TestImportBoardErrors(t *testing.T) {
+	readErr := board.ErrUnsupportedDocumentVersion
+
+	tests := []struct {
+		name     string
+		reader   *snapshotReader
+		snapshot func(s *board.Snapshot)
+		err      error
+	}{
+		{name: "unreadable document", reader: &snapshotReader{err: readErr}, err: readErr},
+		{name: "board without title", snapshot: func(s *board.Snapshot) { s.Structure.Title = "" }, err: board.ErrTitleRequired},
+		// Испорченная задача в конце документа отменяет уже созданные колонки
+		{name: "task without title", snapshot: func(s *board.Snapshot) { s.Structure.Columns[0].Tasks[2].Title = "" }, err: board.ErrInvalidImport},
+		{name: "column without title", snapshot: func(s *board.Snapshot) { s.Structure.Columns[1].Title = "" }, err: board.ErrInvalidImport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.store.addUser(ownerID, "alice")
+			f.store.addUser(2, "bob")
+			mailer := &memoryMailer{}
+
+			reader := tt.reader
+			if reader == nil {
+				s := importSnapshot()
+				tt.snapshot(s)
+				reader = &snapshotReader{snapshot: s}
+			}
+
+			_, err := newImportUseCase(f, reader, mailer).Handle(as(ownerID), ImportBoardCommand{OwnerID: ownerID})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(f.store.boards) != 0 || len(f.store.columns) != 0 || len(f.store.events) != 0 || len(mailer.sent) != 0 {
+				t.Errorf("boards = %d, columns = %d, events = %v, letters = %d after failed import",
+					len(f.store.boards), len(f.store.columns), f.store.eventTypes(), len(mailer.sent))
+			}
+		})
+	}
+}
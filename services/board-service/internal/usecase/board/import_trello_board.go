@@ -0,0 +1,247 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// importSourceTrello — источник доски в событии BoardImported
+const importSourceTrello = "trello"
+
+const (
+	importIssueBoard     = "board"
+	importIssueList      = "list"
+	importIssueCard      = "card"
+	importIssueMember    = "member"
+	importIssueChecklist = "checklist"
+)
+
+// ImportTrelloBoardUseCase создает доску из экспорта Trello: списки становятся колонками,
+// карточки — задачами, участники Trello сопоставляются с пользователями Taskify по логину
+// и получают приглашения редакторами. Всё, что перенести нельзя, попадает в отчет,
+// а не обрывает импорт.
+type ImportTrelloBoardUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	memberRepo board.MemberRepository
+	userRepo   board.UserRepository
+	outbox     Outbox
+	inviter    *ImportInviter
+}
+
+func NewImportTrelloBoardUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, memberRepo board.MemberRepository, userRepo board.UserRepository, outbox Outbox, inviter *ImportInviter) *ImportTrelloBoardUseCase {
+	return &ImportTrelloBoardUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, memberRepo: memberRepo, userRepo: userRepo, outbox: outbox, inviter: inviter}
+}
+
+func (uc *ImportTrelloBoardUseCase) Handle(ctx context.Context, cmd ImportTrelloBoardCommand) (*ImportReport, error) {
+	export, err := parseTrelloExport(cmd.Export)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{Issues: make([]ImportIssue, 0)}
+
+	name, truncated := fitTitle(export.Name, board.MaxBoardTitleLength)
+	if truncated {
+		report.Issues = append(report.Issues, titleTruncated(importIssueBoard, export.ID, export.Name, board.MaxBoardTitleLength))
+	}
+
+	// Без валидной доски импорт невозможен — это ошибка всего запроса, а не пропуск
+	b, err := board.NewBoard(name, export.Desc, cmd.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitations []*importInvitation
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := createOwnedBoard(ctx, uc.boardRepo, uc.memberRepo, uc.outbox, b); err != nil {
+			return err
+		}
+
+		users, invited, err := uc.inviteMembers(ctx, b, export.Members, report)
+		if err != nil {
+			return err
+		}
+		invitations = invited
+
+		columns, err := uc.importLists(ctx, b, export.Lists, report)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.importCards(ctx, export.Cards, columns, users, report); err != nil {
+			return err
+		}
+
+		// Чек-листов в Taskify нет: сообщаем, что они не перенесены
+		for _, c := range export.Checklists {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueChecklist, SourceID: c.ID, Name: c.Name, Reason: "checklists are not supported"})
+		}
+
+		// Одно событие на весь импорт вместо событий о каждой колонке и задаче
+		return uc.outbox.Save(ctx, board.NewBoardImported(b, importSourceTrello, report.Columns, report.Tasks))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.inviter.send(ctx, invitations, report)
+
+	report.Board = b
+
+	return report, nil
+}
+
+// inviteMembers приглашает редакторами участников Trello, для которых нашелся
+// пользователь Taskify с тем же логином. Возвращает id участника Trello -> id пользователя
+// и приглашения, письма по которым уходят после коммита
+func (uc *ImportTrelloBoardUseCase) inviteMembers(ctx context.Context, b *board.Board, members []trelloMember, report *ImportReport) (map[string]int64, []*importInvitation, error) {
+	usernames := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.Username != "" {
+			usernames = append(usernames, m.Username)
+		}
+	}
+
+	found, err := uc.userRepo.FindIDsByUsernames(ctx, usernames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := make(map[string]int64, len(found))
+	invitations := make([]*importInvitation, 0, len(found))
+
+	for _, m := range members {
+		userID, ok := found[strings.ToLower(m.Username)]
+		if !ok {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueMember, SourceID: m.ID, Name: m.Username, Reason: "no Taskify user with this username"})
+			continue
+		}
+
+		users[m.ID] = userID
+
+		// Импортирующий уже владелец доски
+		if userID == b.Owner {
+			continue
+		}
+
+		invitation, err := uc.inviter.invite(ctx, b, userID, board.RoleEditor, m.ID, m.Username)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return users, invitations, nil
+}
+
+// importLists создает колонки из открытых списков в порядке pos.
+// Возвращает id списка Trello -> колонка
+func (uc *ImportTrelloBoardUseCase) importLists(ctx context.Context, b *board.Board, lists []trelloList, report *ImportReport) (map[string]*board.Column, error) {
+	columns := make(map[string]*board.Column, len(lists))
+
+	for _, l := range lists {
+		if l.Closed {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueList, SourceID: l.ID, Name: l.Name, Reason: "list is archived in Trello"})
+			continue
+		}
+
+		title, truncated := fitTitle(l.Name, board.MaxColumnTitleLength)
+		if truncated {
+			report.Issues = append(report.Issues, titleTruncated(importIssueList, l.ID, l.Name, board.MaxColumnTitleLength))
+		}
+
+		column, err := board.NewColumn(b.ID, title, len(columns))
+		if err != nil {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueList, SourceID: l.ID, Name: l.Name, Reason: err.Error()})
+			continue
+		}
+
+		if err := uc.columnRepo.Create(ctx, column); err != nil {
+			return nil, err
+		}
+
+		columns[l.ID] = column
+		report.Columns++
+	}
+
+	return columns, nil
+}
+
+// importCards создает задачи из открытых карточек в порядке pos внутри колонки.
+// Исполнитель в Taskify один — им становится первый сопоставленный участник карточки
+func (uc *ImportTrelloBoardUseCase) importCards(ctx context.Context, cards []trelloCard, columns map[string]*board.Column, users map[string]int64, report *ImportReport) error {
+	positions := make(map[int64]int, len(columns))
+
+	for _, c := range cards {
+		if c.Closed {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueCard, SourceID: c.ID, Name: c.Name, Reason: "card is archived in Trello"})
+			continue
+		}
+
+		column, ok := columns[c.IDList]
+		if !ok {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueCard, SourceID: c.ID, Name: c.Name, Reason: "card list was not imported"})
+			continue
+		}
+
+		var (
+			assigneeID int64
+			mapped     int
+		)
+		for _, memberID := range c.IDMembers {
+			if userID, ok := users[memberID]; ok {
+				if mapped == 0 {
+					assigneeID = userID
+				}
+				mapped++
+			}
+		}
+
+		if mapped > 1 {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueCard, SourceID: c.ID, Name: c.Name, Reason: "card has several members, only one assignee was kept"})
+		}
+
+		title, truncated := fitTitle(c.Name, board.MaxTaskTitleLength)
+		if truncated {
+			report.Issues = append(report.Issues, titleTruncated(importIssueCard, c.ID, c.Name, board.MaxTaskTitleLength))
+		}
+
+		task, err := board.NewTask(column.ID, title, c.Desc, assigneeID, positions[column.ID])
+		if err != nil {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueCard, SourceID: c.ID, Name: c.Name, Reason: err.Error()})
+			continue
+		}
+
+		if err := uc.taskRepo.Create(ctx, task); err != nil {
+			return err
+		}
+
+		positions[column.ID]++
+		report.Tasks++
+	}
+
+	return nil
+}
+
+// fitTitle обрезает название до limit символов. Trello длину не ограничивает,
+// а терять из-за длинного названия карточку или весь импорт незачем
+func fitTitle(title string, limit int) (string, bool) {
+	runes := []rune(title)
+	if len(runes) <= limit {
+		return title, false
+	}
+
+	return string(runes[:limit]), true
+}
+
+func titleTruncated(kind, sourceID, name string, limit int) ImportIssue {
+	return ImportIssue{Kind: kind, SourceID: sourceID, Name: name, Reason: fmt.Sprintf("title is longer than %d characters and was truncated", limit)}
+}
@@ -0,0 +1,81 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// InvitationTokens выдает одноразовые токены приглашений.
+// Generate возвращает сам токен (он уходит письмом) и его хэш (он хранится в БД).
+type InvitationTokens interface {
+	Generate() (token string, hash string, err error)
+	Hash(token string) string
+}
+
+// InvitationMailer доставляет приглашение на email приглашенного
+type InvitationMailer interface {
+	SendInvitation(ctx context.Context, invitation *board.Invitation, token string) error
+}
+
+// ImportInviter приглашает на импортированную доску пользователей, найденных в файле.
+// Импорт не может сделать человека участником без его согласия — только пригласить,
+// как это сделал бы владелец доски вручную
+type ImportInviter struct {
+	invitationRepo board.InvitationRepository
+	userRepo       board.UserRepository
+	tokens         InvitationTokens
+	mailer         InvitationMailer
+	ttl            time.Duration
+}
+
+// NewImportInviter: ttl — сколько приглашение можно принять после импорта
+func NewImportInviter(invitationRepo board.InvitationRepository, userRepo board.UserRepository, tokens InvitationTokens, mailer InvitationMailer, ttl time.Duration) *ImportInviter {
+	return &ImportInviter{invitationRepo: invitationRepo, userRepo: userRepo, tokens: tokens, mailer: mailer, ttl: ttl}
+}
+
+// importInvitation — приглашение, созданное импортом. Письмо с token уходит после коммита
+type importInvitation struct {
+	invitation *board.Invitation
+	token      string
+	sourceID   string
+	name       string
+}
+
+// invite создает приглашение от имени владельца доски. Вызывается внутри транзакции импорта
+func (i *ImportInviter) invite(ctx context.Context, b *board.Board, userID int64, role board.Role, sourceID, name string) (*importInvitation, error) {
+	email, err := i.userRepo.GetEmail(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, hash, err := i.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := board.NewInvitation(b.ID, email, role, b.Owner, hash, i.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return &importInvitation{invitation: invitation, token: token, sourceID: sourceID, name: name}, nil
+}
+
+// send рассылает письма после коммита. Доска уже создана, поэтому неудачная отправка
+// не отменяет импорт, а попадает в отчет: пригласить можно заново
+func (i *ImportInviter) send(ctx context.Context, invitations []*importInvitation, report *ImportReport) {
+	for _, inv := range invitations {
+		if err := i.mailer.SendInvitation(ctx, inv.invitation, inv.token); err != nil {
+			report.Issues = append(report.Issues, ImportIssue{Kind: importIssueMember, SourceID: inv.sourceID, Name: inv.name, Reason: "failed to send invitation: " + err.Error()})
+			continue
+		}
+
+		report.Invitations++
+	}
+}
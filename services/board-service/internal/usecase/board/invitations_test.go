@@ -0,0 +1,289 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// inviteeEmail — адрес strangerID из seedMembers
+const inviteeEmail = "user5@example.com"
+
+// seqTokens выдает token-1, token-2, ... и хэширует их префиксом
+type seqTokens struct{ n int }
+
+func (g *seqTokens) Generate() (string, string, error) {
+	g.n++
+	token := "token-" + strconv.Itoa(g.n)
+	return token, g.Hash(token), nil
+}
+
+func (g *seqTokens) Hash(token string) string { return "hash:" + token }
+
+// memoryMailer запоминает токены отправленных писем
+type memoryMailer struct {
+	sent []string
+	err  error
+}
+
+func (m *memoryMailer) SendInvitation(ctx context.Context, invitation *board.Invitation, token string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, token)
+	return nil
+}
+
+func (f *fixture) invite(t *testing.T, tokens *seqTokens, mailer *memoryMailer, caller int64, cmd CreateInvitationCommand) (*board.Invitation, error) {
+	t.Helper()
+
+	uc := NewCreateInvitationUseCase(f.store, f.boards, f.invitations, tokens, mailer, f.auth, time.Hour)
+	return uc.Handle(as(caller), cmd)
+}
+
+func TestCreateInvitation(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		email  string
+		role   string
+		err    error
+	}{
+		{name: "owner invites admin", caller: ownerID, email: inviteeEmail, role: "admin"},
+		{name: "admin invites editor", caller: adminID, email: inviteeEmail, role: "editor"},
+		{name: "admin invites admin", caller: adminID, email: inviteeEmail, role: "admin", err: board.ErrForbidden},
+		{name: "editor invites viewer", caller: editorID, email: inviteeEmail, role: "viewer", err: board.ErrForbidden},
+		{name: "not a member", caller: strangerID, email: inviteeEmail, role: "viewer", err: board.ErrForbidden},
+		{name: "owner role", caller: ownerID, email: inviteeEmail, role: "owner", err: board.ErrOwnerRoleImmutable},
+		{name: "unknown role", caller: ownerID, email: inviteeEmail, role: "guest", err: board.ErrInvalidRole},
+		{name: "invalid email", caller: ownerID, email: "user5", role: "viewer", err: board.ErrInvalidEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			mailer := &memoryMailer{}
+
+			inv, err := f.invite(t, &seqTokens{}, mailer, tt.caller, CreateInvitationCommand{BoardID: b.ID, Email: tt.email, Role: tt.role})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			want := 1
+			if tt.err != nil {
+				want = 0
+			}
+			if pending := f.store.pendingInvitations(b.ID); len(pending) != want {
+				t.Fatalf("pending invitations = %d, want %d", len(pending), want)
+			}
+			if len(mailer.sent) != want {
+				t.Fatalf("sent = %v, want %d letters", mailer.sent, want)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			// В БД лежит только хэш, сам токен уходит письмом
+			if inv.TokenHash != "hash:"+mailer.sent[0] || inv.InvitedBy != tt.caller || inv.Email != inviteeEmail {
+				t.Errorf("invitation = %+v, letter token %q", inv, mailer.sent[0])
+			}
+		})
+	}
+}
+
+func TestCreateInvitationReplacesPending(t *testing.T) {
+	f := newFixture()
+	b := f.seedMembers(t)
+	tokens, mailer := &seqTokens{}, &memoryMailer{}
+
+	for _, email := range []string{inviteeEmail, "USER5@example.com"} {
+		if _, err := f.invite(t, tokens, mailer, ownerID, CreateInvitationCommand{BoardID: b.ID, Email: email, Role: "viewer"}); err != nil {
+			t.Fatalf("invite %s: %v", email, err)
+		}
+	}
+
+	pending := f.store.pendingInvitations(b.ID)
+	if len(pending) != 1 || pending[0].TokenHash != tokens.Hash("token-2") {
+		t.Fatalf("pending invitations = %+v, want only the second one", pending)
+	}
+
+	accept := NewAcceptInvitationUseCase(f.store, f.invitations, f.members, f.users, tokens, f.boards)
+	if _, err := accept.Handle(as(strangerID), "token-1"); !errors.Is(err, board.ErrInvitationRevoked) {
+		t.Errorf("first token err = %v, want %v", err, board.ErrInvitationRevoked)
+	}
+	if _, err := accept.Handle(as(strangerID), "token-2"); err != nil {
+		t.Errorf("second token: %v", err)
+	}
+}
+
+// Письмо уходит после коммита: приглашение остается в БД, и его можно отправить заново
+func TestCreateInvitationMailerFailure(t *testing.T) {
+	f := newFixture()
+	b := f.seedMembers(t)
+	sendErr := errors.New("smtp is down")
+
+	_, err := f.invite(t, &seqTokens{}, &memoryMailer{err: sendErr}, ownerID, CreateInvitationCommand{BoardID: b.ID, Email: inviteeEmail, Role: "viewer"})
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("err = %v, want %v", err, sendErr)
+	}
+	if pending := f.store.pendingInvitations(b.ID); len(pending) != 1 {
+		t.Errorf("pending invitations = %d, want 1", len(pending))
+	}
+}
+
+func TestAcceptInvitationToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		token string
+		// prepare выполняется после того, как приглашение отправлено
+		prepare func(t *testing.T, f *fixture, accept *AcceptInvitationUseCase)
+		err     error
+	}{
+		{name: "invitee", ctx: as(strangerID), token: "token-1"},
+		{name: "wrong token", ctx: as(strangerID), token: "token-2", err: board.ErrInvitationNotFound},
+		{name: "empty token", ctx: as(strangerID), token: "", err: board.ErrInvitationNotFound},
+		{name: "anonymous", ctx: context.Background(), token: "token-1", err: board.ErrForbidden},
+		{name: "another user", ctx: as(viewerID), token: "token-1", err: board.ErrInvitationEmailMismatch},
+		{
+			name:  "second use",
+			ctx:   as(strangerID),
+			token: "token-1",
+			prepare: func(t *testing.T, f *fixture, accept *AcceptInvitationUseCase) {
+				if _, err := accept.Handle(as(strangerID), "token-1"); err != nil {
+					t.Fatalf("first accept: %v", err)
+				}
+			},
+			err: board.ErrInvitationAlreadyUsed,
+		},
+		{
+			name:  "expired",
+			ctx:   as(strangerID),
+			token: "token-1",
+			prepare: func(t *testing.T, f *fixture, accept *AcceptInvitationUseCase) {
+				for id, inv := range f.store.invitations {
+					inv.ExpiresAt = time.Now().Add(-time.Second)
+					f.store.invitations[id] = inv
+				}
+			},
+			err: board.ErrInvitationExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			tokens := &seqTokens{}
+			if _, err := f.invite(t, tokens, &memoryMailer{}, ownerID, CreateInvitationCommand{BoardID: b.ID, Email: inviteeEmail, Role: "editor"}); err != nil {
+				t.Fatalf("invite: %v", err)
+			}
+
+			accept := NewAcceptInvitationUseCase(f.store, f.invitations, f.members, f.users, tokens, f.boards)
+			if tt.prepare != nil {
+				tt.prepare(t, f, accept)
+			}
+
+			_, err := accept.Handle(tt.ctx, tt.token)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && f.role(b.ID, strangerID) != board.RoleEditor {
+				t.Errorf("role = %q, want editor", f.role(b.ID, strangerID))
+			}
+		})
+	}
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		role   string
+		// otherBoard — отзывать через другую доску того же владельца
+		otherBoard bool
+		err        error
+	}{
+		{name: "owner revokes admin invitation", caller: ownerID, role: "admin"},
+		{name: "admin revokes editor invitation", caller: adminID, role: "editor"},
+		{name: "admin revokes admin invitation", caller: adminID, role: "admin", err: board.ErrForbidden},
+		{name: "editor", caller: editorID, role: "viewer", err: board.ErrForbidden},
+		{name: "through another board", caller: ownerID, role: "viewer", otherBoard: true, err: board.ErrInvitationNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			inv, err := f.invite(t, &seqTokens{}, &memoryMailer{}, ownerID, CreateInvitationCommand{BoardID: b.ID, Email: inviteeEmail, Role: tt.role})
+			if err != nil {
+				t.Fatalf("invite: %v", err)
+			}
+
+			boardID := b.ID
+			if tt.otherBoard {
+				other, _ := f.seedBoard(ownerID, "Other", nil)
+				boardID = other.ID
+			}
+
+			uc := NewRevokeInvitationUseCase(f.store, f.boards, f.invitations, f.auth)
+			err = uc.Handle(as(tt.caller), RevokeInvitationCommand{BoardID: boardID, InvitationID: inv.ID})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			want := 0
+			if tt.err != nil {
+				want = 1
+			}
+			if pending := f.store.pendingInvitations(b.ID); len(pending) != want {
+				t.Fatalf("pending invitations = %d, want %d", len(pending), want)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if err := uc.Handle(as(tt.caller), RevokeInvitationCommand{BoardID: b.ID, InvitationID: inv.ID}); !errors.Is(err, board.ErrInvitationRevoked) {
+				t.Errorf("second revoke err = %v, want %v", err, board.ErrInvitationRevoked)
+			}
+		})
+	}
+}
+
+func TestListInvitations(t *testing.T) {
+	f := newFixture()
+	b := f.seedMembers(t)
+	if _, err := f.invite(t, &seqTokens{}, &memoryMailer{}, ownerID, CreateInvitationCommand{BoardID: b.ID, Email: inviteeEmail, Role: "viewer"}); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		caller int64
+		want   int
+		err    error
+	}{
+		{name: "owner", caller: ownerID, want: 1},
+		{name: "admin", caller: adminID, want: 1},
+		{name: "editor", caller: editorID, err: board.ErrForbidden},
+		{name: "not a member", caller: strangerID, err: board.ErrForbidden},
+	}
+
+	uc := NewListInvitationsUseCase(f.boards, f.invitations, f.auth)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := uc.Handle(as(tt.caller), b.ID)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(list) != tt.want {
+				t.Errorf("invitations = %d, want %d", len(list), tt.want)
+			}
+		})
+	}
+}
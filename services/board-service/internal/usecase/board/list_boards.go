@@ -2,8 +2,17 @@ package board
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
 
 	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
 )
 
 type ListBoardsUseCase struct {
@@ -14,11 +23,132 @@ func NewListBoardsUseCase(repo board.Repository) *ListBoardsUseCase {
 	return &ListBoardsUseCase{repo: repo}
 }
 
-func (uc *ListBoardsUseCase) Handle(ctx context.Context) ([]*board.Board, error) {
-	receivedBoards, err := uc.repo.GetList(ctx)
+// Handle возвращает страницу досок, где вызывающий пользователь владелец или участник.
+// NextPageToken пустой, если страница последняя.
+func (uc *ListBoardsUseCase) Handle(ctx context.Context, q ListBoardsQuery) (*ListBoardsResult, error) {
+	userID, ok := identity.UserID(ctx)
+	if !ok {
+		return nil, board.ErrForbidden
+	}
+
+	sortBy, err := board.ParseSortField(q.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	descending, err := parseOrder(q.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := board.ParseArchiveFilter(q.Archived)
 	if err != nil {
 		return nil, err
 	}
 
-	return receivedBoards, nil
+	pageSize := q.PageSize
+	switch {
+	case pageSize < 0:
+		return nil, board.ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	query := board.ListQuery{
+		UserID:     userID,
+		Search:     q.Search,
+		Archived:   archived,
+		SortBy:     sortBy,
+		Descending: descending,
+		// Лишняя запись показывает, есть ли следующая страница
+		Limit: pageSize + 1,
+	}
+
+	if q.PageToken != "" {
+		token, err := decodePageToken(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// Токен действует только для того же порядка и фильтра, в котором был выдан
+		if token.SortBy != sortBy || token.Descending != descending || token.Search != q.Search || token.Archived != archived {
+			return nil, board.ErrInvalidPageToken
+		}
+
+		query.After = &board.ListCursor{Value: token.Value, ID: token.ID}
+	}
+
+	boards, err := uc.repo.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListBoardsResult{Boards: boards}
+
+	if len(boards) > pageSize {
+		result.Boards = boards[:pageSize]
+
+		cursor := board.CursorOf(result.Boards[pageSize-1], sortBy)
+		result.NextPageToken, err = encodePageToken(pageToken{
+			SortBy:     sortBy,
+			Descending: descending,
+			Search:     q.Search,
+			Archived:   archived,
+			Value:      cursor.Value,
+			ID:         cursor.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// parseOrder: по умолчанию новые доски идут первыми
+func parseOrder(order string) (bool, error) {
+	switch order {
+	case "", "desc":
+		return true, nil
+	case "asc":
+		return false, nil
+	default:
+		return false, board.ErrInvalidSort
+	}
+}
+
+// pageToken — содержимое непрозрачного токена страницы.
+// Клиент не должен его разбирать: формат может поменяться.
+type pageToken struct {
+	SortBy     board.SortField     `json:"s"`
+	Descending bool                `json:"d"`
+	Search     string              `json:"q,omitempty"`
+	Archived   board.ArchiveFilter `json:"a"`
+	Value      time.Time           `json:"v"`
+	ID         int64               `json:"i"`
+}
+
+func encodePageToken(t pageToken) (string, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, board.ErrInvalidPageToken
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return nil, board.ErrInvalidPageToken
+	}
+
+	return &t, nil
 }
@@ -0,0 +1,259 @@
+package board
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestPageToken(t *testing.T) {
+	valid := pageToken{
+		SortBy:     board.SortByUpdatedAt,
+		Descending: true,
+		Search:     "плана",
+		Archived:   board.ArchiveFilterAll,
+		Value:      time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC),
+		ID:         42,
+	}
+	encoded, err := encodePageToken(valid)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{name: "round trip", token: encoded},
+		{name: "not base64", token: "%%%", err: board.ErrInvalidPageToken},
+		{name: "padded base64", token: encoded + "=", err: board.ErrInvalidPageToken},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("boards after 42")), err: board.ErrInvalidPageToken},
+		{name: "wrong field types", token: base64.RawURLEncoding.EncodeToString([]byte(`{"i":"42"}`)), err: board.ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePageToken(tt.token)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if got.SortBy != valid.SortBy || got.Descending != valid.Descending || got.Search != valid.Search ||
+				got.Archived != valid.Archived || !got.Value.Equal(valid.Value) || got.ID != valid.ID {
+				t.Errorf("decoded = %+v, want %+v", got, valid)
+			}
+		})
+	}
+}
+
+// seedTimeline создает доски 1..n пользователя ownerID, созданные с шагом в минуту.
+// Доски 3 и 4 созданы в одну и ту же минуту: их порядок решает id.
+func (f *fixture) seedTimeline(n int) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= n; i++ {
+		b, _ := f.seedBoard(ownerID, "Board "+strconv.Itoa(i), nil)
+
+		minute := i
+		if i == 4 {
+			minute = 3
+		}
+		f.store.updateBoard(b.ID, func(b *board.Board) {
+			b.CreatedAt = start.Add(time.Duration(minute) * time.Minute)
+			// По времени изменения порядок обратный
+			b.UpdatedAt = start.Add(time.Duration(n-i) * time.Minute)
+		})
+	}
+}
+
+func TestListBoardsPaging(t *testing.T) {
+	tests := []struct {
+		name   string
+		sortBy string
+		order  string
+		want   []string
+	}{
+		{name: "newest first", want: []string{"Board 5", "Board 4", "Board 3", "Board 2", "Board 1"}},
+		{name: "oldest first", order: "asc", want: []string{"Board 1", "Board 2", "Board 3", "Board 4", "Board 5"}},
+		{name: "recently updated first", sortBy: "updated_at", order: "desc", want: []string{"Board 1", "Board 2", "Board 3", "Board 4", "Board 5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.seedTimeline(5)
+			uc := NewListBoardsUseCase(f.boards)
+
+			var got []string
+			query := ListBoardsQuery{PageSize: 2, SortBy: tt.sortBy, Order: tt.order}
+			for pages := 1; ; pages++ {
+				result, err := uc.Handle(as(ownerID), query)
+				if err != nil {
+					t.Fatalf("page %d: %v", pages, err)
+				}
+				for _, b := range result.Boards {
+					got = append(got, b.Title)
+				}
+				if result.NextPageToken == "" {
+					if pages != 3 {
+						t.Errorf("pages = %d, want 3", pages)
+					}
+					break
+				}
+				query.PageToken = result.NextPageToken
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("boards = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBoardsQuery(t *testing.T) {
+	f := newFixture()
+	f.seedTimeline(3)
+	f.seedBoard(ownerID, "Квартальный план", nil)
+	// Чужая доска не видна, даже если совпадает с поиском
+	f.store.ensureUser(newOwnerID)
+	f.seedBoard(newOwnerID, "План отпуска", nil)
+
+	uc := NewListBoardsUseCase(f.boards)
+	first, err := uc.Handle(as(ownerID), ListBoardsQuery{PageSize: 1})
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		query ListBoardsQuery
+		want  int
+		err   error
+	}{
+		{name: "only own boards", ctx: as(ownerID), want: 4},
+		{name: "search ignores case", ctx: as(ownerID), query: ListBoardsQuery{Search: "ПЛАН"}, want: 1},
+		{name: "search without matches", ctx: as(ownerID), query: ListBoardsQuery{Search: "roadmap"}, want: 0},
+		{name: "another user", ctx: as(newOwnerID), want: 1},
+		{name: "anonymous", ctx: context.Background(), err: board.ErrForbidden},
+		{name: "negative page size", ctx: as(ownerID), query: ListBoardsQuery{PageSize: -1}, err: board.ErrInvalidPageSize},
+		{name: "unknown sort field", ctx: as(ownerID), query: ListBoardsQuery{SortBy: "title"}, err: board.ErrInvalidSort},
+		{name: "unknown order", ctx: as(ownerID), query: ListBoardsQuery{Order: "up"}, err: board.ErrInvalidSort},
+		{name: "garbage token", ctx: as(ownerID), query: ListBoardsQuery{PageToken: "garbage!"}, err: board.ErrInvalidPageToken},
+		{name: "next page", ctx: as(ownerID), query: ListBoardsQuery{PageToken: first.NextPageToken}, want: 3},
+		// Токен выдан для другого порядка или поиска
+		{name: "token with another order", ctx: as(ownerID), query: ListBoardsQuery{PageToken: first.NextPageToken, Order: "asc"}, err: board.ErrInvalidPageToken},
+		{name: "token with another sort", ctx: as(ownerID), query: ListBoardsQuery{PageToken: first.NextPageToken, SortBy: "updated_at"}, err: board.ErrInvalidPageToken},
+		{name: "token with another search", ctx: as(ownerID), query: ListBoardsQuery{PageToken: first.NextPageToken, Search: "план"}, err: board.ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.Handle(tt.ctx, tt.query)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if len(result.Boards) != tt.want {
+				t.Errorf("boards = %d, want %d", len(result.Boards), tt.want)
+			}
+		})
+	}
+}
+
+func TestListBoardsPageSize(t *testing.T) {
+	f := newFixture()
+	f.seedTimeline(maxPageSize + 1)
+	uc := NewListBoardsUseCase(f.boards)
+
+	tests := []struct {
+		name     string
+		pageSize int
+		want     int
+	}{
+		{name: "default", pageSize: 0, want: defaultPageSize},
+		{name: "exact", pageSize: 7, want: 7},
+		{name: "above maximum", pageSize: maxPageSize * 10, want: maxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.Handle(as(ownerID), ListBoardsQuery{PageSize: tt.pageSize})
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if len(result.Boards) != tt.want || result.NextPageToken == "" {
+				t.Errorf("boards = %d, next page %q, want %d and a token", len(result.Boards), result.NextPageToken, tt.want)
+			}
+		})
+	}
+}
+
+func TestListBoardsArchiveFilter(t *testing.T) {
+	f := newFixture()
+	f.seedBoard(1, "Active", nil)
+	archived, _ := f.seedBoard(1, "Archived", nil)
+	deleted, _ := f.seedBoard(1, "Deleted", nil)
+
+	f.store.updateBoard(archived.ID, func(b *board.Board) { _ = b.Archive(time.Now()) })
+	f.store.updateBoard(deleted.ID, func(b *board.Board) { _ = b.SoftDelete(time.Now()) })
+
+	// Участник удаленной доски не может её восстановить, поэтому и не видит
+	f.store.ensureUser(2)
+	for _, b := range []*board.Board{archived, deleted} {
+		if err := f.members.Create(as(1), &board.Member{BoardID: b.ID, UserID: 2, Role: board.RoleEditor}); err != nil {
+			t.Fatalf("add member: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		userID   int64
+		archived string
+		want     []string
+		err      error
+	}{
+		{name: "default", userID: 1, want: []string{"Active"}},
+		{name: "active", userID: 1, archived: "active", want: []string{"Active"}},
+		{name: "archived", userID: 1, archived: "archived", want: []string{"Archived"}},
+		{name: "all", userID: 1, archived: "all", want: []string{"Active", "Archived"}},
+		{name: "deleted by owner", userID: 1, archived: "deleted", want: []string{"Deleted"}},
+		{name: "deleted by member", userID: 2, archived: "deleted", want: nil},
+		{name: "archived by member", userID: 2, archived: "archived", want: []string{"Archived"}},
+		{name: "unknown filter", userID: 1, archived: "trash", err: board.ErrInvalidArchiveFilter},
+	}
+
+	uc := NewListBoardsUseCase(f.boards)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.Handle(as(tt.userID), ListBoardsQuery{Archived: tt.archived, Order: "asc"})
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+
+			var got []string
+			for _, b := range result.Boards {
+				got = append(got, b.Title)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("boards = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
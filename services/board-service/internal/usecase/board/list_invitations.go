@@ -0,0 +1,32 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type ListInvitationsUseCase struct {
+	boardRepo      board.Repository
+	invitationRepo board.InvitationRepository
+	auth           *Authorizer
+}
+
+func NewListInvitationsUseCase(boardRepo board.Repository, invitationRepo board.InvitationRepository, auth *Authorizer) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{boardRepo: boardRepo, invitationRepo: invitationRepo, auth: auth}
+}
+
+// Handle возвращает действующие приглашения доски. Видят их те, кто управляет участниками.
+func (uc *ListInvitationsUseCase) Handle(ctx context.Context, boardID int64) ([]*board.Invitation, error) {
+	b, err := uc.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.auth.Authorize(ctx, b, board.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	return uc.invitationRepo.ListPendingByBoard(ctx, boardID, time.Now())
+}
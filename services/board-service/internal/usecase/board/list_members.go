@@ -0,0 +1,31 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type ListMembersUseCase struct {
+	boardRepo  board.Repository
+	memberRepo board.MemberRepository
+	auth       *Authorizer
+}
+
+func NewListMembersUseCase(boardRepo board.Repository, memberRepo board.MemberRepository, auth *Authorizer) *ListMembersUseCase {
+	return &ListMembersUseCase{boardRepo: boardRepo, memberRepo: memberRepo, auth: auth}
+}
+
+// Handle возвращает участников доски; видеть их может любой участник
+func (uc *ListMembersUseCase) Handle(ctx context.Context, boardID int64) ([]*board.Member, error) {
+	b, err := uc.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.auth.Authorize(ctx, b, board.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return uc.memberRepo.ListByBoard(ctx, boardID)
+}
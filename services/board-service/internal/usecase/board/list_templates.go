@@ -0,0 +1,27 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+type ListTemplatesUseCase struct {
+	repo board.Repository
+}
+
+func NewListTemplatesUseCase(repo board.Repository) *ListTemplatesUseCase {
+	return &ListTemplatesUseCase{repo: repo}
+}
+
+// Handle возвращает шаблоны, доступные вызывающему пользователю для клонирования:
+// доски-шаблоны, где он владелец или участник
+func (uc *ListTemplatesUseCase) Handle(ctx context.Context) ([]*board.Board, error) {
+	userID, ok := identity.UserID(ctx)
+	if !ok {
+		return nil, board.ErrForbidden
+	}
+
+	return uc.repo.ListTemplates(ctx, userID)
+}
@@ -0,0 +1,219 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// Участники доски из seedMembers; stranger существует, но на доске его нет
+const (
+	adminID    int64 = 2
+	editorID   int64 = 3
+	viewerID   int64 = 4
+	strangerID int64 = 5
+)
+
+// seedMembers создает доску ownerID с админом, редактором и зрителем
+func (f *fixture) seedMembers(t *testing.T) *board.Board {
+	t.Helper()
+
+	b, _ := f.seedBoard(ownerID, "Board", nil)
+	for userID, role := range map[int64]board.Role{adminID: board.RoleAdmin, editorID: board.RoleEditor, viewerID: board.RoleViewer} {
+		f.store.ensureUser(userID)
+		if err := f.members.Create(as(ownerID), &board.Member{BoardID: b.ID, UserID: userID, Role: role}); err != nil {
+			t.Fatalf("add member: %v", err)
+		}
+	}
+	f.store.ensureUser(strangerID)
+
+	return b
+}
+
+// role возвращает роль пользователя на доске или "", если его там нет
+func (f *fixture) role(boardID, userID int64) board.Role {
+	m, err := f.members.Get(as(ownerID), boardID, userID)
+	if err != nil {
+		return ""
+	}
+	return m.Role
+}
+
+func TestAuthorizerRoles(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		want   board.Role
+		// edit — может ли пользователь менять доску
+		edit bool
+		err  error
+	}{
+		{name: "owner", caller: ownerID, want: board.RoleOwner, edit: true},
+		{name: "admin", caller: adminID, want: board.RoleAdmin, edit: true},
+		{name: "editor", caller: editorID, want: board.RoleEditor, edit: true},
+		{name: "viewer", caller: viewerID, want: board.RoleViewer},
+		{name: "not a member", caller: strangerID, err: board.ErrForbidden},
+	}
+
+	f := newFixture()
+	b := f.seedMembers(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := f.auth.Role(as(tt.caller), b)
+			if !errors.Is(err, tt.err) || role != tt.want {
+				t.Fatalf("Role = %q, %v, want %q, %v", role, err, tt.want, tt.err)
+			}
+
+			err = f.auth.Authorize(as(tt.caller), b, board.PermissionEdit)
+			if (err == nil) != tt.edit {
+				t.Errorf("Authorize(edit) = %v, want allowed %v", err, tt.edit)
+			}
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		userID int64
+		role   string
+		err    error
+	}{
+		{name: "owner adds admin", caller: ownerID, userID: strangerID, role: "admin"},
+		{name: "admin adds editor", caller: adminID, userID: strangerID, role: "editor"},
+		{name: "admin adds viewer", caller: adminID, userID: strangerID, role: "viewer"},
+		{name: "admin adds admin", caller: adminID, userID: strangerID, role: "admin", err: board.ErrForbidden},
+		{name: "editor adds viewer", caller: editorID, userID: strangerID, role: "viewer", err: board.ErrForbidden},
+		{name: "not a member", caller: strangerID, userID: strangerID, role: "viewer", err: board.ErrForbidden},
+		{name: "owner role", caller: ownerID, userID: strangerID, role: "owner", err: board.ErrOwnerRoleImmutable},
+		{name: "unknown role", caller: ownerID, userID: strangerID, role: "guest", err: board.ErrInvalidRole},
+		{name: "unknown user", caller: ownerID, userID: 99, role: "viewer", err: board.ErrUserNotFound},
+		{name: "already a member", caller: ownerID, userID: editorID, role: "viewer", err: board.ErrMemberAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			before := f.role(b.ID, tt.userID)
+
+			uc := NewAddMemberUseCase(f.store, f.boards, f.members, f.users, f.auth)
+			_, err := uc.Handle(as(tt.caller), AddMemberCommand{BoardID: b.ID, UserID: tt.userID, Role: tt.role})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			want := board.Role(tt.role)
+			if tt.err != nil {
+				want = before
+			}
+			if got := f.role(b.ID, tt.userID); got != want {
+				t.Errorf("role = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		userID int64
+		role   string
+		want   board.Role
+		err    error
+	}{
+		{name: "owner promotes editor to admin", caller: ownerID, userID: editorID, role: "admin", want: board.RoleAdmin},
+		{name: "owner demotes admin", caller: ownerID, userID: adminID, role: "viewer", want: board.RoleViewer},
+		{name: "admin promotes viewer to editor", caller: adminID, userID: viewerID, role: "editor", want: board.RoleEditor},
+		{name: "admin promotes editor to admin", caller: adminID, userID: editorID, role: "admin", want: board.RoleEditor, err: board.ErrForbidden},
+		{name: "editor demotes viewer", caller: editorID, userID: viewerID, role: "viewer", want: board.RoleViewer, err: board.ErrForbidden},
+		{name: "owner role", caller: ownerID, userID: adminID, role: "owner", want: board.RoleAdmin, err: board.ErrOwnerRoleImmutable},
+		{name: "demote owner", caller: adminID, userID: ownerID, role: "viewer", want: board.RoleOwner, err: board.ErrOwnerRoleImmutable},
+		{name: "unknown role", caller: ownerID, userID: editorID, role: "guest", want: board.RoleEditor, err: board.ErrInvalidRole},
+		{name: "not a member", caller: ownerID, userID: strangerID, role: "viewer", err: board.ErrMemberNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+
+			uc := NewUpdateMemberRoleUseCase(f.store, f.boards, f.members, f.auth)
+			_, err := uc.Handle(as(tt.caller), UpdateMemberRoleCommand{BoardID: b.ID, UserID: tt.userID, Role: tt.role})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if got := f.role(b.ID, tt.userID); got != tt.want {
+				t.Errorf("role = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller int64
+		userID int64
+		err    error
+	}{
+		{name: "viewer leaves", caller: viewerID, userID: viewerID},
+		{name: "admin leaves", caller: adminID, userID: adminID},
+		{name: "owner removes admin", caller: ownerID, userID: adminID},
+		{name: "admin removes editor", caller: adminID, userID: editorID},
+		{name: "editor removes viewer", caller: editorID, userID: viewerID, err: board.ErrForbidden},
+		{name: "not a member removes viewer", caller: strangerID, userID: viewerID, err: board.ErrForbidden},
+		{name: "owner leaves", caller: ownerID, userID: ownerID, err: board.ErrOwnerRoleImmutable},
+		{name: "admin removes owner", caller: adminID, userID: ownerID, err: board.ErrOwnerRoleImmutable},
+		{name: "unknown member", caller: ownerID, userID: strangerID, err: board.ErrMemberNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b := f.seedMembers(t)
+			before := f.role(b.ID, tt.userID)
+
+			err := NewRemoveMemberUseCase(f.store, f.boards, f.members, f.auth).Handle(as(tt.caller), RemoveMemberCommand{BoardID: b.ID, UserID: tt.userID})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			want := before
+			if tt.err == nil {
+				want = ""
+			}
+			if got := f.role(b.ID, tt.userID); got != want {
+				t.Errorf("role = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestListMembers(t *testing.T) {
+	f := newFixture()
+	b := f.seedMembers(t)
+	uc := NewListMembersUseCase(f.boards, f.members, f.auth)
+
+	members, err := uc.Handle(as(viewerID), b.ID)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	var got []int64
+	for _, m := range members {
+		got = append(got, m.UserID)
+	}
+	// От владельца к зрителю
+	if want := []int64{ownerID, adminID, editorID, viewerID}; !slices.Equal(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+
+	if _, err := uc.Handle(as(strangerID), b.ID); !errors.Is(err, board.ErrForbidden) {
+		t.Errorf("not a member err = %v, want %v", err, board.ErrForbidden)
+	}
+}
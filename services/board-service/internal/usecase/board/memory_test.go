@@ -0,0 +1,1014 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+// memoryStore — данные сервиса в памяти для тестов сценариев. Транзакции идут
+// строго по одной, как под блокировкой доски, и откатываются при ошибке
+type memoryStore struct {
+	txMu sync.Mutex
+	mu   sync.Mutex
+
+	nextID      int64
+	boards      map[int64]board.Board
+	columns     map[int64]board.Column
+	tasks       map[int64]board.Task
+	members     map[memberKey]board.Member
+	invitations map[int64]board.Invitation
+	users       map[int64]memoryUser
+	transfers   []board.OwnershipTransfer
+	events      []board.Event
+	idempotency map[idempotencyKey]board.IdempotencyRecord
+	// readOnlyTx — сколько раз открывалась транзакция только на чтение
+	readOnlyTx int
+	// locked — id досок, прочитанных с блокировкой
+	locked []int64
+}
+
+type memberKey struct {
+	boardID int64
+	userID  int64
+}
+
+type idempotencyKey struct {
+	userID    int64
+	operation string
+	key       string
+}
+
+type memoryUser struct {
+	username string
+	email    string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		boards:      make(map[int64]board.Board),
+		columns:     make(map[int64]board.Column),
+		tasks:       make(map[int64]board.Task),
+		members:     make(map[memberKey]board.Member),
+		invitations: make(map[int64]board.Invitation),
+		users:       make(map[int64]memoryUser),
+		idempotency: make(map[idempotencyKey]board.IdempotencyRecord),
+	}
+}
+
+func (s *memoryStore) id() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// addUser регистрирует пользователя; адрес выводится из логина
+func (s *memoryStore) addUser(id int64, username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[id] = memoryUser{username: username, email: username + "@example.com"}
+}
+
+// ensureUser регистрирует пользователя userN, если его еще нет
+func (s *memoryStore) ensureUser(id int64) {
+	s.mu.Lock()
+	_, ok := s.users[id]
+	s.mu.Unlock()
+
+	if !ok {
+		s.addUser(id, "user"+strconv.FormatInt(id, 10))
+	}
+}
+
+// updateBoard меняет сохраненную доску в обход сценариев, для подготовки состояния
+func (s *memoryStore) updateBoard(id int64, fn func(b *board.Board)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.boards[id]
+	fn(&b)
+	s.boards[id] = b
+}
+
+// lock запоминает, что доска прочитана с блокировкой
+func (s *memoryStore) lock(boardID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locked = append(s.locked, boardID)
+}
+
+// eventTypes возвращает типы сохраненных событий по порядку
+func (s *memoryStore) eventTypes() []board.EventType {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	types := make([]board.EventType, 0, len(s.events))
+	for _, e := range s.events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+// columnTasks возвращает задачи колонки по позиции
+func (s *memoryStore) columnTasks(columnID int64) []board.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var tasks []board.Task
+	for _, t := range s.tasks {
+		if t.ColumnID == columnID {
+			tasks = append(tasks, t)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Position < tasks[j].Position })
+	return tasks
+}
+
+type txKey struct{}
+
+func (s *memoryStore) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) != nil {
+		return fn(ctx)
+	}
+
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	s.mu.Lock()
+	saved := s.snapshot()
+	s.mu.Unlock()
+
+	if err := fn(context.WithValue(ctx, txKey{}, true)); err != nil {
+		s.mu.Lock()
+		s.restore(saved)
+		s.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// WithinReadOnlyTransaction работает как WithinTransaction: пока транзакция идет,
+// никто другой данные не меняет, так что снимок и так согласован
+func (s *memoryStore) WithinReadOnlyTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.mu.Lock()
+	s.readOnlyTx++
+	s.mu.Unlock()
+
+	return s.WithinTransaction(ctx, fn)
+}
+
+func (s *memoryStore) snapshot() *memoryStore {
+	return &memoryStore{
+		nextID:      s.nextID,
+		boards:      maps.Clone(s.boards),
+		columns:     maps.Clone(s.columns),
+		tasks:       maps.Clone(s.tasks),
+		members:     maps.Clone(s.members),
+		invitations: maps.Clone(s.invitations),
+		users:       maps.Clone(s.users),
+		transfers:   slices.Clone(s.transfers),
+		events:      slices.Clone(s.events),
+		idempotency: maps.Clone(s.idempotency),
+	}
+}
+
+func (s *memoryStore) restore(saved *memoryStore) {
+	s.nextID = saved.nextID
+	s.boards = saved.boards
+	s.columns = saved.columns
+	s.tasks = saved.tasks
+	s.members = saved.members
+	s.invitations = saved.invitations
+	s.users = saved.users
+	s.transfers = saved.transfers
+	s.events = saved.events
+	s.idempotency = saved.idempotency
+}
+
+// memoryBoards реализует board.Repository
+type memoryBoards struct{ s *memoryStore }
+
+func (r memoryBoards) Create(ctx context.Context, b *board.Board) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	b.ID = r.s.id()
+	b.Version = 1
+	r.s.boards[b.ID] = *b
+	return nil
+}
+
+func (r memoryBoards) get(id int64, withDeleted bool) (*board.Board, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	b, ok := r.s.boards[id]
+	if !ok || (b.DeletedAt != nil && !withDeleted) {
+		return nil, board.ErrBoardNotFound
+	}
+	return &b, nil
+}
+
+func (r memoryBoards) GetByID(ctx context.Context, id int64) (*board.Board, error) {
+	return r.get(id, false)
+}
+
+func (r memoryBoards) GetByIDForUpdate(ctx context.Context, id int64) (*board.Board, error) {
+	r.s.lock(id)
+	return r.get(id, false)
+}
+
+func (r memoryBoards) GetAnyByIDForUpdate(ctx context.Context, id int64) (*board.Board, error) {
+	r.s.lock(id)
+	return r.get(id, true)
+}
+
+func (r memoryBoards) List(ctx context.Context, q board.ListQuery) ([]*board.Board, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	value := func(b *board.Board) time.Time { return board.CursorOf(b, q.SortBy).Value }
+	// before сообщает, идет ли a раньше b в порядке списка
+	before := func(a, b *board.Board) bool {
+		va, vb := value(a), value(b)
+		if !va.Equal(vb) {
+			return va.Before(vb) != q.Descending
+		}
+		// Доска не идет раньше самой себя: курсор исключает последнюю отданную
+		return a.ID != b.ID && (a.ID < b.ID) != q.Descending
+	}
+
+	var list []*board.Board
+	for _, b := range r.s.boards {
+		if _, ok := r.s.members[memberKey{b.ID, q.UserID}]; !ok {
+			continue
+		}
+		if !archiveMatches(&b, q.Archived, q.UserID) {
+			continue
+		}
+		if q.Search != "" && !strings.Contains(strings.ToLower(b.Title), strings.ToLower(q.Search)) {
+			continue
+		}
+		if q.After != nil && !before(&board.Board{ID: q.After.ID, CreatedAt: q.After.Value, UpdatedAt: q.After.Value}, &b) {
+			continue
+		}
+		list = append(list, &b)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return before(list[i], list[j]) })
+
+	if len(list) > q.Limit {
+		list = list[:q.Limit]
+	}
+	return list, nil
+}
+
+// archiveMatches повторяет условия BoardRepository.List по archived_at и deleted_at
+func archiveMatches(b *board.Board, filter board.ArchiveFilter, userID int64) bool {
+	if filter == board.ArchiveFilterDeleted {
+		return b.DeletedAt != nil && b.Owner == userID
+	}
+	if b.DeletedAt != nil {
+		return false
+	}
+
+	switch filter {
+	case board.ArchiveFilterActive:
+		return b.ArchivedAt == nil
+	case board.ArchiveFilterArchived:
+		return b.ArchivedAt != nil
+	default:
+		return true
+	}
+}
+
+func (r memoryBoards) ListTemplates(ctx context.Context, userID int64) ([]*board.Board, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var list []*board.Board
+	for _, b := range r.s.boards {
+		if _, ok := r.s.members[memberKey{b.ID, userID}]; ok && b.IsTemplate && b.DeletedAt == nil {
+			list = append(list, &b)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Title < list[j].Title })
+	return list, nil
+}
+
+func (r memoryBoards) Update(ctx context.Context, b *board.Board) (*board.Board, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	stored, ok := r.s.boards[b.ID]
+	if !ok || stored.Version != b.Version {
+		return nil, board.ErrVersionConflict
+	}
+
+	updated := *b
+	updated.Version++
+	r.s.boards[b.ID] = updated
+	return &updated, nil
+}
+
+func (r memoryBoards) PurgeDeleted(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var ids []int64
+	for id, b := range r.s.boards {
+		if b.DeletedAt != nil && !b.DeletedAt.After(before) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	for _, id := range ids {
+		delete(r.s.boards, id)
+		for columnID, c := range r.s.columns {
+			if c.BoardID == id {
+				r.s.deleteColumn(columnID)
+			}
+		}
+		for key := range r.s.members {
+			if key.boardID == id {
+				delete(r.s.members, key)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// deleteColumn удаляет колонку вместе с задачами, как ON DELETE CASCADE
+func (s *memoryStore) deleteColumn(id int64) {
+	delete(s.columns, id)
+	for taskID, t := range s.tasks {
+		if t.ColumnID == id {
+			delete(s.tasks, taskID)
+		}
+	}
+}
+
+// memoryColumns реализует board.ColumnRepository
+type memoryColumns struct{ s *memoryStore }
+
+func (r memoryColumns) Create(ctx context.Context, c *board.Column) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	c.ID = r.s.id()
+	r.s.columns[c.ID] = *c
+	return nil
+}
+
+func (r memoryColumns) GetByID(ctx context.Context, id int64) (*board.Column, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	c, ok := r.s.columns[id]
+	if !ok {
+		return nil, board.ErrColumnNotFound
+	}
+	return &c, nil
+}
+
+func (r memoryColumns) ListByBoard(ctx context.Context, boardID int64) ([]*board.Column, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	list := make([]*board.Column, 0)
+	for _, c := range r.s.columns {
+		if c.BoardID == boardID {
+			list = append(list, &c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Position < list[j].Position })
+	return list, nil
+}
+
+func (r memoryColumns) CountByBoard(ctx context.Context, boardID int64) (int, error) {
+	list, _ := r.ListByBoard(ctx, boardID)
+	return len(list), nil
+}
+
+func (r memoryColumns) Update(ctx context.Context, c *board.Column) (*board.Column, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.columns[c.ID]; !ok {
+		return nil, board.ErrColumnNotFound
+	}
+	r.s.columns[c.ID] = *c
+	updated := *c
+	return &updated, nil
+}
+
+func (r memoryColumns) ShiftPositions(ctx context.Context, boardID int64, from, to, delta int) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for id, c := range r.s.columns {
+		if c.BoardID == boardID && c.Position >= from && c.Position <= to {
+			c.Position += delta
+			r.s.columns[id] = c
+		}
+	}
+	return nil
+}
+
+func (r memoryColumns) Delete(ctx context.Context, id int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.columns[id]; !ok {
+		return board.ErrColumnNotFound
+	}
+	r.s.deleteColumn(id)
+	return nil
+}
+
+// memoryTasks реализует board.TaskRepository
+type memoryTasks struct{ s *memoryStore }
+
+func (r memoryTasks) Create(ctx context.Context, t *board.Task) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.users[t.AssigneeID]; t.AssigneeID != 0 && !ok {
+		return board.ErrAssigneeNotFound
+	}
+
+	t.ID = r.s.id()
+	r.s.tasks[t.ID] = *t
+	return nil
+}
+
+func (r memoryTasks) GetByID(ctx context.Context, id int64) (*board.Task, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	t, ok := r.s.tasks[id]
+	if !ok {
+		return nil, board.ErrTaskNotFound
+	}
+	return &t, nil
+}
+
+func (r memoryTasks) ListByBoard(ctx context.Context, boardID int64) ([]*board.Task, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	list := make([]*board.Task, 0)
+	for _, t := range r.s.tasks {
+		if c, ok := r.s.columns[t.ColumnID]; ok && c.BoardID == boardID {
+			list = append(list, &t)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		ci, cj := r.s.columns[list[i].ColumnID].Position, r.s.columns[list[j].ColumnID].Position
+		if ci != cj {
+			return ci < cj
+		}
+		return list[i].Position < list[j].Position
+	})
+	return list, nil
+}
+
+func (r memoryTasks) CountByColumn(ctx context.Context, columnID int64) (int, error) {
+	return len(r.s.columnTasks(columnID)), nil
+}
+
+func (r memoryTasks) Update(ctx context.Context, t *board.Task) (*board.Task, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.tasks[t.ID]; !ok {
+		return nil, board.ErrTaskNotFound
+	}
+	if _, ok := r.s.users[t.AssigneeID]; t.AssigneeID != 0 && !ok {
+		return nil, board.ErrAssigneeNotFound
+	}
+	r.s.tasks[t.ID] = *t
+	updated := *t
+	return &updated, nil
+}
+
+func (r memoryTasks) ShiftPositions(ctx context.Context, columnID int64, from, to, delta int) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for id, t := range r.s.tasks {
+		if t.ColumnID == columnID && t.Position >= from && t.Position <= to {
+			t.Position += delta
+			r.s.tasks[id] = t
+		}
+	}
+	return nil
+}
+
+func (r memoryTasks) Delete(ctx context.Context, id int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.tasks[id]; !ok {
+		return board.ErrTaskNotFound
+	}
+	delete(r.s.tasks, id)
+	return nil
+}
+
+// memoryMembers реализует board.MemberRepository
+type memoryMembers struct{ s *memoryStore }
+
+func (r memoryMembers) Create(ctx context.Context, m *board.Member) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := memberKey{m.BoardID, m.UserID}
+	if _, ok := r.s.members[key]; ok {
+		return board.ErrMemberAlreadyExists
+	}
+	if _, ok := r.s.users[m.UserID]; !ok {
+		return board.ErrUserNotFound
+	}
+	r.s.members[key] = *m
+	return nil
+}
+
+func (r memoryMembers) Get(ctx context.Context, boardID, userID int64) (*board.Member, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	m, ok := r.s.members[memberKey{boardID, userID}]
+	if !ok {
+		return nil, board.ErrMemberNotFound
+	}
+	return &m, nil
+}
+
+func (r memoryMembers) ListByBoard(ctx context.Context, boardID int64) ([]*board.Member, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	rank := map[board.Role]int{board.RoleOwner: 0, board.RoleAdmin: 1, board.RoleEditor: 2, board.RoleViewer: 3}
+
+	list := make([]*board.Member, 0)
+	for _, m := range r.s.members {
+		if m.BoardID == boardID {
+			list = append(list, &m)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if rank[list[i].Role] != rank[list[j].Role] {
+			return rank[list[i].Role] < rank[list[j].Role]
+		}
+		return list[i].UserID < list[j].UserID
+	})
+	return list, nil
+}
+
+func (r memoryMembers) Update(ctx context.Context, m *board.Member) (*board.Member, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := memberKey{m.BoardID, m.UserID}
+	if _, ok := r.s.members[key]; !ok {
+		return nil, board.ErrMemberNotFound
+	}
+	r.s.members[key] = *m
+	updated := *m
+	return &updated, nil
+}
+
+func (r memoryMembers) Save(ctx context.Context, m *board.Member) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	r.s.members[memberKey{m.BoardID, m.UserID}] = *m
+	return nil
+}
+
+func (r memoryMembers) Delete(ctx context.Context, boardID, userID int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := memberKey{boardID, userID}
+	if _, ok := r.s.members[key]; !ok {
+		return board.ErrMemberNotFound
+	}
+	delete(r.s.members, key)
+	return nil
+}
+
+// memoryInvitations реализует board.InvitationRepository
+type memoryInvitations struct{ s *memoryStore }
+
+func (r memoryInvitations) Create(ctx context.Context, inv *board.Invitation) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	inv.ID = r.s.id()
+	r.s.invitations[inv.ID] = *inv
+	return nil
+}
+
+func (r memoryInvitations) GetByID(ctx context.Context, id int64) (*board.Invitation, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	inv, ok := r.s.invitations[id]
+	if !ok {
+		return nil, board.ErrInvitationNotFound
+	}
+	return &inv, nil
+}
+
+func (r memoryInvitations) GetByTokenHash(ctx context.Context, tokenHash string) (*board.Invitation, error) {
+	return r.GetByTokenHashForUpdate(ctx, tokenHash)
+}
+
+func (r memoryInvitations) GetByTokenHashForUpdate(ctx context.Context, tokenHash string) (*board.Invitation, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, inv := range r.s.invitations {
+		if inv.TokenHash == tokenHash {
+			return &inv, nil
+		}
+	}
+	return nil, board.ErrInvitationNotFound
+}
+
+func (r memoryInvitations) ListPendingByBoard(ctx context.Context, boardID int64, now time.Time) ([]*board.Invitation, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	list := make([]*board.Invitation, 0)
+	for _, inv := range r.s.invitations {
+		if inv.BoardID == boardID && inv.Pending(now) {
+			list = append(list, &inv)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+func (r memoryInvitations) RevokePending(ctx context.Context, boardID int64, email string, now time.Time) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var revoked int64
+	for id, inv := range r.s.invitations {
+		if inv.BoardID == boardID && inv.Email == email && inv.Pending(now) {
+			inv.RevokedAt = &now
+			r.s.invitations[id] = inv
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func (r memoryInvitations) Update(ctx context.Context, inv *board.Invitation) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.invitations[inv.ID]; !ok {
+		return board.ErrInvitationNotFound
+	}
+	r.s.invitations[inv.ID] = *inv
+	return nil
+}
+
+// pendingInvitations возвращает действующие приглашения доски
+func (s *memoryStore) pendingInvitations(boardID int64) []board.Invitation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var list []board.Invitation
+	for _, inv := range s.invitations {
+		if inv.BoardID == boardID && inv.Pending(time.Now()) {
+			list = append(list, inv)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// memoryUsers реализует board.UserRepository
+type memoryUsers struct{ s *memoryStore }
+
+func (r memoryUsers) Exists(ctx context.Context, id int64) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	_, ok := r.s.users[id]
+	return ok, nil
+}
+
+func (r memoryUsers) GetEmail(ctx context.Context, id int64) (string, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	u, ok := r.s.users[id]
+	if !ok {
+		return "", board.ErrUserNotFound
+	}
+	return u.email, nil
+}
+
+func (r memoryUsers) FindIDsByUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	found := make(map[string]int64)
+	for _, name := range usernames {
+		for id, u := range r.s.users {
+			if strings.EqualFold(u.username, name) {
+				found[strings.ToLower(name)] = id
+			}
+		}
+	}
+	return found, nil
+}
+
+func (r memoryUsers) GetUsernames(ctx context.Context, ids []int64) (map[int64]string, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	found := make(map[int64]string)
+	for _, id := range ids {
+		if u, ok := r.s.users[id]; ok {
+			found[id] = u.username
+		}
+	}
+	return found, nil
+}
+
+// memoryTransfers реализует board.TransferRepository
+type memoryTransfers struct{ s *memoryStore }
+
+func (r memoryTransfers) Create(ctx context.Context, t *board.OwnershipTransfer) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	t.ID = r.s.id()
+	r.s.transfers = append(r.s.transfers, *t)
+	return nil
+}
+
+// memoryIdempotency реализует board.IdempotencyRepository
+type memoryIdempotency struct{ s *memoryStore }
+
+func (r memoryIdempotency) Reserve(ctx context.Context, rec *board.IdempotencyRecord) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := idempotencyKey{rec.UserID, rec.Operation, rec.Key}
+	if stored, ok := r.s.idempotency[key]; ok && stored.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+	r.s.idempotency[key] = *rec
+	return true, nil
+}
+
+func (r memoryIdempotency) Get(ctx context.Context, userID int64, operation, key string) (*board.IdempotencyRecord, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	rec, ok := r.s.idempotency[idempotencyKey{userID, operation, key}]
+	if !ok {
+		return nil, errors.New("idempotency key not found")
+	}
+	return &rec, nil
+}
+
+func (r memoryIdempotency) SaveResponse(ctx context.Context, userID int64, operation, key string, response []byte) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	k := idempotencyKey{userID, operation, key}
+	rec := r.s.idempotency[k]
+	rec.Response = response
+	r.s.idempotency[k] = rec
+	return nil
+}
+
+func (r memoryIdempotency) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var deleted int64
+	for key, rec := range r.s.idempotency {
+		if !rec.ExpiresAt.After(now) {
+			delete(r.s.idempotency, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// memoryOutbox реализует Outbox
+type memoryOutbox struct{ s *memoryStore }
+
+func (o memoryOutbox) Save(ctx context.Context, events ...board.Event) error {
+	o.s.mu.Lock()
+	defer o.s.mu.Unlock()
+
+	o.s.events = append(o.s.events, events...)
+	return nil
+}
+
+// memoryCache реализует BoardCache с поколениями, как в Redis, и считает сбросы по доскам
+type memoryCache struct {
+	mu          sync.Mutex
+	entries     map[int64]CachedStructure
+	generations map[int64]int64
+	invalidated map[int64]int
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		entries:     make(map[int64]CachedStructure),
+		generations: make(map[int64]int64),
+		invalidated: make(map[int64]int),
+	}
+}
+
+func (c *memoryCache) Get(ctx context.Context, boardID int64) (*CachedStructure, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.entries[boardID]
+	if !ok {
+		return nil, nil
+	}
+	return &cached, nil
+}
+
+func (c *memoryCache) Generation(ctx context.Context, boardID int64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[boardID], nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, structure *board.Structure, generation int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[structure.ID] == generation {
+		c.entries[structure.ID] = CachedStructure{Structure: structure, StoredAt: time.Now()}
+	}
+	return nil
+}
+
+func (c *memoryCache) Invalidate(ctx context.Context, boardID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, boardID)
+	c.generations[boardID]++
+	c.invalidated[boardID]++
+	return nil
+}
+
+// put кладет структуру в кэш так, будто её сохранили в storedAt
+func (c *memoryCache) put(structure *board.Structure, storedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[structure.ID] = CachedStructure{Structure: structure, StoredAt: storedAt}
+}
+
+func (c *memoryCache) invalidations(boardID int64) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.invalidated[boardID]
+}
+
+// fixture — хранилище с репозиториями поверх него и сценарии, собранные так же, как в main
+type fixture struct {
+	store       *memoryStore
+	boards      memoryBoards
+	columns     memoryColumns
+	tasks       memoryTasks
+	members     memoryMembers
+	invitations memoryInvitations
+	users       memoryUsers
+	transfers   memoryTransfers
+	outbox      memoryOutbox
+	cache       *memoryCache
+	auth        *Authorizer
+}
+
+func newFixture() *fixture {
+	s := newMemoryStore()
+
+	f := &fixture{
+		store:       s,
+		boards:      memoryBoards{s},
+		columns:     memoryColumns{s},
+		tasks:       memoryTasks{s},
+		members:     memoryMembers{s},
+		invitations: memoryInvitations{s},
+		users:       memoryUsers{s},
+		transfers:   memoryTransfers{s},
+		outbox:      memoryOutbox{s},
+		cache:       newMemoryCache(),
+	}
+	f.auth = NewAuthorizer(f.members)
+
+	return f
+}
+
+// as возвращает контекст запроса от имени пользователя
+func as(userID int64) context.Context {
+	return identity.WithUserID(context.Background(), userID)
+}
+
+// seedBoard создает доску владельца с колонками, в каждой — задачи с указанными названиями
+func (f *fixture) seedBoard(ownerID int64, title string, columns map[string][]string, order ...string) (*board.Board, []*board.Column) {
+	b, err := board.NewBoard(title, "", ownerID)
+	if err != nil {
+		panic(err)
+	}
+
+	f.store.ensureUser(ownerID)
+
+	ctx := context.Background()
+	if err := createOwnedBoard(ctx, f.boards, f.members, f.outbox, b); err != nil {
+		panic(err)
+	}
+
+	created := make([]*board.Column, 0, len(order))
+	for i, name := range order {
+		c, err := board.NewColumn(b.ID, name, i)
+		if err != nil {
+			panic(err)
+		}
+		if err := f.columns.Create(ctx, c); err != nil {
+			panic(err)
+		}
+
+		for j, taskTitle := range columns[name] {
+			t, err := board.NewTask(c.ID, taskTitle, "", 0, j)
+			if err != nil {
+				panic(err)
+			}
+			if err := f.tasks.Create(ctx, t); err != nil {
+				panic(err)
+			}
+		}
+
+		created = append(created, c)
+	}
+
+	return b, created
+}
+
+// taskTitles возвращает названия задач колонки по позиции и проверяет,
+// что позиции идут подряд с нуля
+func (f *fixture) taskTitles(columnID int64) ([]string, bool) {
+	tasks := f.store.columnTasks(columnID)
+
+	titles := make([]string, 0, len(tasks))
+	dense := true
+	for i, t := range tasks {
+		if t.Position != i {
+			dense = false
+		}
+		titles = append(titles, t.Title)
+	}
+	return titles, dense
+}
+
+// columnTitles возвращает названия колонок доски по позиции и проверяет,
+// что позиции идут подряд с нуля
+func (f *fixture) columnTitles(boardID int64) ([]string, bool) {
+	columns, _ := f.columns.ListByBoard(context.Background(), boardID)
+
+	titles := make([]string, 0, len(columns))
+	dense := true
+	for i, c := range columns {
+		if c.Position != i {
+			dense = false
+		}
+		titles = append(titles, c.Title)
+	}
+	return titles, dense
+}
@@ -0,0 +1,99 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type MoveBoardUseCase struct {
+	tx           TxManager
+	repo         board.Repository
+	memberRepo   board.MemberRepository
+	userRepo     board.UserRepository
+	transferRepo board.TransferRepository
+	outbox       Outbox
+	cache        BoardCache
+	auth         *Authorizer
+}
+
+func NewMoveBoardUseCase(tx TxManager, repo board.Repository, memberRepo board.MemberRepository, userRepo board.UserRepository, transferRepo board.TransferRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *MoveBoardUseCase {
+	return &MoveBoardUseCase{tx: tx, repo: repo, memberRepo: memberRepo, userRepo: userRepo, transferRepo: transferRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle передает доску другому владельцу и записывает, кто это сделал
+func (uc *MoveBoardUseCase) Handle(ctx context.Context, cmd MoveBoardCommand) (*board.Board, error) {
+	var movedBoard *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		currentBoard, err := uc.repo.GetByIDForUpdate(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, currentBoard, board.PermissionDelete); err != nil {
+			return err
+		}
+
+		// Передача самому себе ничего не меняет
+		if currentBoard.Owner == cmd.Owner {
+			movedBoard = currentBoard
+			return nil
+		}
+
+		exists, err := uc.userRepo.Exists(ctx, cmd.Owner)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return board.ErrUserNotFound
+		}
+
+		transfer, err := currentBoard.TransferTo(cmd.Owner, cmd.MovedBy)
+		if err != nil {
+			return err
+		}
+
+		movedBoard, err = uc.repo.Update(ctx, currentBoard)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+			return err
+		}
+
+		if err := uc.handOverMembership(ctx, transfer); err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardMoved(transfer))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.ID)
+
+	return movedBoard, nil
+}
+
+// handOverMembership переносит роль owner в board_members: прежний владелец
+// остается на доске админом, новый становится владельцем
+func (uc *MoveBoardUseCase) handOverMembership(ctx context.Context, transfer *board.OwnershipTransfer) error {
+	previous, err := board.NewMember(transfer.BoardID, transfer.FromUserID, board.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.memberRepo.Save(ctx, previous); err != nil {
+		return err
+	}
+
+	next, err := board.NewMember(transfer.BoardID, transfer.ToUserID, board.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	return uc.memberRepo.Save(ctx, next)
+}
@@ -0,0 +1,71 @@
+package board
+
+import (
+	"errors"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestMoveBoard(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  int64
+		owner   int64
+		err     error
+		want    int64
+		records int
+	}{
+		{name: "owner to member", caller: 1, owner: 2, want: 2, records: 1},
+		{name: "owner to user outside the board", caller: 1, owner: 4, want: 4, records: 1},
+		{name: "to current owner", caller: 1, owner: 1, want: 1},
+		{name: "to unknown user", caller: 1, owner: 99, err: board.ErrUserNotFound, want: 1},
+		{name: "by admin", caller: 2, owner: 4, err: board.ErrForbidden, want: 1},
+		{name: "by editor", caller: 3, owner: 3, err: board.ErrForbidden, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, _ := f.seedBoard(1, "Board", nil)
+			for _, m := range []board.Member{{UserID: 2, Role: board.RoleAdmin}, {UserID: 3, Role: board.RoleEditor}} {
+				f.store.ensureUser(m.UserID)
+				m.BoardID = b.ID
+				if err := f.members.Create(as(1), &m); err != nil {
+					t.Fatalf("add member: %v", err)
+				}
+			}
+			f.store.ensureUser(4)
+
+			uc := NewMoveBoardUseCase(f.store, f.boards, f.members, f.users, f.transfers, f.outbox, f.cache, f.auth)
+			_, err := uc.Handle(as(tt.caller), MoveBoardCommand{ID: b.ID, Owner: tt.owner, MovedBy: tt.caller})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			stored, _ := f.boards.GetByID(as(1), b.ID)
+			if stored.Owner != tt.want {
+				t.Errorf("owner = %d, want %d", stored.Owner, tt.want)
+			}
+			if len(f.store.transfers) != tt.records {
+				t.Fatalf("transfers = %d, want %d", len(f.store.transfers), tt.records)
+			}
+			if tt.records == 0 {
+				return
+			}
+
+			transfer := f.store.transfers[0]
+			if transfer.FromUserID != 1 || transfer.ToUserID != tt.owner || transfer.TransferredBy != tt.caller {
+				t.Errorf("transfer = %+v", transfer)
+			}
+
+			// Прежний владелец остается админом, новый получает роль owner
+			for userID, role := range map[int64]board.Role{1: board.RoleAdmin, tt.owner: board.RoleOwner} {
+				m, err := f.members.Get(as(1), b.ID, userID)
+				if err != nil || m.Role != role {
+					t.Errorf("member %d = %v (err %v), want %s", userID, m, err, role)
+				}
+			}
+		})
+	}
+}
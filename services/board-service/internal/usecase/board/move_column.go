@@ -0,0 +1,84 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type MoveColumnUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewMoveColumnUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *MoveColumnUseCase {
+	return &MoveColumnUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle переставляет колонку на новую позицию, сдвигая соседние так,
+// чтобы позиции оставались непрерывными: 0, 1, ..., n-1
+func (uc *MoveColumnUseCase) Handle(ctx context.Context, cmd MoveColumnCommand) (*board.Column, error) {
+	var moved *board.Column
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		column, err := getBoardColumn(ctx, uc.columnRepo, cmd.BoardID, cmd.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		count, err := uc.columnRepo.CountByBoard(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Position < 0 || cmd.Position >= count {
+			return board.ErrInvalidPosition
+		}
+
+		if cmd.Position == column.Position {
+			moved = column
+			return nil
+		}
+
+		// Соседи между старой и новой позицией сдвигаются на одну в сторону освободившегося места
+		if cmd.Position < column.Position {
+			err = uc.columnRepo.ShiftPositions(ctx, cmd.BoardID, cmd.Position, column.Position-1, 1)
+		} else {
+			err = uc.columnRepo.ShiftPositions(ctx, cmd.BoardID, column.Position+1, cmd.Position, -1)
+		}
+		if err != nil {
+			return err
+		}
+
+		column.Position = cmd.Position
+		column.UpdatedAt = time.Now()
+
+		moved, err = uc.columnRepo.Update(ctx, column)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewColumnMoved(moved))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return moved, nil
+}
@@ -1 +1,124 @@
 package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type MoveTaskUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewMoveTaskUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *MoveTaskUseCase {
+	return &MoveTaskUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle переносит задачу в другую колонку и/или на другую позицию.
+// Всё выполняется в одной транзакции под блокировкой доски: параллельные перемещения
+// на той же доске выстраиваются в очередь, поэтому позиции в обеих колонках
+// остаются непрерывными и без дубликатов.
+func (uc *MoveTaskUseCase) Handle(ctx context.Context, cmd MoveTaskCommand) (*board.Task, error) {
+	var moved *board.Task
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		// Задачу читаем уже под блокировкой, чтобы видеть актуальную позицию
+		task, err := getBoardTask(ctx, uc.taskRepo, uc.columnRepo, cmd.BoardID, cmd.TaskID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := getBoardColumn(ctx, uc.columnRepo, cmd.BoardID, cmd.ColumnID); err != nil {
+			return err
+		}
+
+		if cmd.ColumnID == task.ColumnID {
+			err = uc.reorderWithinColumn(ctx, task, cmd.Position)
+		} else {
+			err = uc.moveToColumn(ctx, task, cmd.ColumnID, cmd.Position)
+		}
+		if err != nil {
+			return err
+		}
+
+		fromColumnID, fromPosition := task.ColumnID, task.Position
+
+		if err := task.MoveTo(cmd.ColumnID, cmd.Position); err != nil {
+			return err
+		}
+
+		moved, err = uc.taskRepo.Update(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewTaskMoved(b, moved, fromColumnID, fromPosition))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return moved, nil
+}
+
+func (uc *MoveTaskUseCase) reorderWithinColumn(ctx context.Context, task *board.Task, position int) error {
+	count, err := uc.taskRepo.CountByColumn(ctx, task.ColumnID)
+	if err != nil {
+		return err
+	}
+
+	if position < 0 || position >= count {
+		return board.ErrInvalidPosition
+	}
+
+	switch {
+	case position < task.Position:
+		return uc.taskRepo.ShiftPositions(ctx, task.ColumnID, position, task.Position-1, 1)
+	case position > task.Position:
+		return uc.taskRepo.ShiftPositions(ctx, task.ColumnID, task.Position+1, position, -1)
+	default:
+		return nil
+	}
+}
+
+func (uc *MoveTaskUseCase) moveToColumn(ctx context.Context, task *board.Task, columnID int64, position int) error {
+	sourceCount, err := uc.taskRepo.CountByColumn(ctx, task.ColumnID)
+	if err != nil {
+		return err
+	}
+
+	targetCount, err := uc.taskRepo.CountByColumn(ctx, columnID)
+	if err != nil {
+		return err
+	}
+
+	// В чужой колонке можно встать и в самый конец, поэтому граница включительная
+	if position < 0 || position > targetCount {
+		return board.ErrInvalidPosition
+	}
+
+	// Закрываем место в исходной колонке
+	if err := uc.taskRepo.ShiftPositions(ctx, task.ColumnID, task.Position+1, sourceCount-1, -1); err != nil {
+		return err
+	}
+
+	// Освобождаем место в колонке назначения
+	return uc.taskRepo.ShiftPositions(ctx, columnID, position, targetCount-1, 1)
+}
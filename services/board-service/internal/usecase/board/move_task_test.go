@@ -0,0 +1,115 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestMoveTask(t *testing.T) {
+	tests := []struct {
+		name     string
+		task     string
+		column   int
+		position int
+		wantA    []string
+		wantB    []string
+		err      error
+	}{
+		{name: "down within column", task: "a0", column: 0, position: 2, wantA: []string{"a1", "a2", "a0", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "up within column", task: "a3", column: 0, position: 1, wantA: []string{"a0", "a3", "a1", "a2"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the end of column", task: "a1", column: 0, position: 3, wantA: []string{"a0", "a2", "a3", "a1"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the start of column", task: "a2", column: 0, position: 0, wantA: []string{"a2", "a0", "a1", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "same position", task: "a1", column: 0, position: 1, wantA: []string{"a0", "a1", "a2", "a3"}, wantB: []string{"b0", "b1", "b2"}},
+		{name: "to the start of another column", task: "a1", column: 1, position: 0, wantA: []string{"a0", "a2", "a3"}, wantB: []string{"a1", "b0", "b1", "b2"}},
+		{name: "into the middle of another column", task: "a0", column: 1, position: 2, wantA: []string{"a1", "a2", "a3"}, wantB: []string{"b0", "b1", "a0", "b2"}},
+		{name: "to the end of another column", task: "a3", column: 1, position: 3, wantA: []string{"a0", "a1", "a2"}, wantB: []string{"b0", "b1", "b2", "a3"}},
+		{name: "past the end of own column", task: "a0", column: 0, position: 4, err: board.ErrInvalidPosition},
+		{name: "past the end of another column", task: "a0", column: 1, position: 4, err: board.ErrInvalidPosition},
+		{name: "negative position", task: "a0", column: 1, position: -1, err: board.ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(1, "Board", map[string][]string{
+				"A": {"a0", "a1", "a2", "a3"},
+				"B": {"b0", "b1", "b2"},
+			}, "A", "B")
+
+			var taskID int64
+			for _, task := range f.store.columnTasks(columns[0].ID) {
+				if task.Title == tt.task {
+					taskID = task.ID
+				}
+			}
+
+			uc := NewMoveTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+			moved, err := uc.Handle(as(1), MoveTaskCommand{BoardID: b.ID, TaskID: taskID, ColumnID: columns[tt.column].ID, Position: tt.position})
+
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				// Отклоненное перемещение не меняет доску
+				tt.wantA, tt.wantB = []string{"a0", "a1", "a2", "a3"}, []string{"b0", "b1", "b2"}
+			} else {
+				if err != nil {
+					t.Fatalf("Handle: %v", err)
+				}
+				if moved.ColumnID != columns[tt.column].ID || moved.Position != tt.position {
+					t.Errorf("moved to column %d position %d, want %d/%d", moved.ColumnID, moved.Position, columns[tt.column].ID, tt.position)
+				}
+			}
+
+			for i, want := range [][]string{tt.wantA, tt.wantB} {
+				got, dense := f.taskTitles(columns[i].ID)
+				if !slices.Equal(got, want) {
+					t.Errorf("column %d = %v, want %v", i, got, want)
+				}
+				if !dense {
+					t.Errorf("column %d positions are not 0..n-1", i)
+				}
+			}
+		})
+	}
+}
+
+func TestMoveTaskForbiddenForViewer(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0", "a1"}}, "A")
+
+	f.store.ensureUser(2)
+	if err := f.members.Create(as(1), &board.Member{BoardID: b.ID, UserID: 2, Role: board.RoleViewer}); err != nil {
+		t.Fatalf("add viewer: %v", err)
+	}
+
+	task := f.store.columnTasks(columns[0].ID)[0]
+	_, err := NewMoveTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+		Handle(as(2), MoveTaskCommand{BoardID: b.ID, TaskID: task.ID, ColumnID: columns[0].ID, Position: 1})
+	if !errors.Is(err, board.ErrForbidden) {
+		t.Fatalf("err = %v, want ErrForbidden", err)
+	}
+}
+
+func TestMoveTaskFromAnotherBoard(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0"}}, "A")
+	_, otherColumns := f.seedBoard(1, "Other", map[string][]string{"X": {"x0"}}, "X")
+
+	foreign := f.store.columnTasks(otherColumns[0].ID)[0]
+	uc := NewMoveTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+
+	// Задача чужой доски
+	if _, err := uc.Handle(as(1), MoveTaskCommand{BoardID: b.ID, TaskID: foreign.ID, ColumnID: columns[0].ID}); !errors.Is(err, board.ErrTaskNotFound) {
+		t.Fatalf("foreign task: err = %v, want ErrTaskNotFound", err)
+	}
+
+	// Колонка чужой доски
+	own := f.store.columnTasks(columns[0].ID)[0]
+	if _, err := uc.Handle(as(1), MoveTaskCommand{BoardID: b.ID, TaskID: own.ID, ColumnID: otherColumns[0].ID}); !errors.Is(err, board.ErrColumnNotFound) {
+		t.Fatalf("foreign column: err = %v, want ErrColumnNotFound", err)
+	}
+}
@@ -0,0 +1,13 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// Outbox сохраняет доменные события. Вызывается внутри той же транзакции,
+// что и само изменение: событие не потеряется и не появится для отмененной записи.
+type Outbox interface {
+	Save(ctx context.Context, events ...board.Event) error
+}
@@ -0,0 +1,78 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestWriteUseCasesSaveEvent(t *testing.T) {
+	for _, tt := range writeCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			f, b := runWriteCase(t, tt)
+
+			if got := f.store.eventTypes(); !slices.Equal(got, []board.EventType{tt.event}) {
+				t.Fatalf("events = %v, want [%s]", got, tt.event)
+			}
+			if e := f.store.events[0]; e.BoardID != b.ID {
+				t.Errorf("event board = %d, want %d", e.BoardID, b.ID)
+			}
+		})
+	}
+}
+
+// TestFailedWriteSavesNoEvent: событие пишется в той же транзакции, что и изменение,
+// поэтому откат изменения убирает и событие
+func TestFailedWriteSavesNoEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(f *fixture, b *board.Board, columns []*board.Column) error
+		err  error
+	}{
+		{
+			name: "stale version",
+			run: func(f *fixture, b *board.Board, _ []*board.Column) error {
+				stale, title := b.Version-1, "Renamed"
+				_, err := NewUpdateBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth).
+					Handle(as(ownerID), UpdateBoardCommand{ID: b.ID, Title: &title, ExpectedVersion: &stale})
+				return err
+			},
+			err: board.ErrVersionConflict,
+		},
+		{
+			name: "invalid position",
+			run: func(f *fixture, b *board.Board, columns []*board.Column) error {
+				_, err := NewMoveColumnUseCase(f.store, f.boards, f.columns, f.outbox, f.cache, f.auth).
+					Handle(as(ownerID), MoveColumnCommand{BoardID: b.ID, ColumnID: columns[0].ID, Position: 5})
+				return err
+			},
+			err: board.ErrInvalidPosition,
+		},
+		{
+			name: "unknown assignee",
+			run: func(f *fixture, b *board.Board, columns []*board.Column) error {
+				_, err := NewCreateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).
+					Handle(as(ownerID), CreateTaskCommand{BoardID: b.ID, ColumnID: columns[0].ID, Title: "New", AssigneeID: 99})
+				return err
+			},
+			err: board.ErrAssigneeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(ownerID, "Board", nil, "Todo", "Done")
+			f.store.events = nil
+
+			if err := tt.run(f, b, columns); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if got := f.store.eventTypes(); len(got) != 0 {
+				t.Fatalf("events = %v after a failed write, want none", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// PurgeDeletedBoardsUseCase окончательно удаляет доски, пролежавшие удаленными дольше retention
+type PurgeDeletedBoardsUseCase struct {
+	tx        TxManager
+	repo      board.Repository
+	retention time.Duration
+	batchSize int
+}
+
+func NewPurgeDeletedBoardsUseCase(tx TxManager, repo board.Repository, retention time.Duration, batchSize int) *PurgeDeletedBoardsUseCase {
+	return &PurgeDeletedBoardsUseCase{tx: tx, repo: repo, retention: retention, batchSize: batchSize}
+}
+
+// Handle очищает одну пачку и возвращает id удаленных досок
+func (uc *PurgeDeletedBoardsUseCase) Handle(ctx context.Context) ([]int64, error) {
+	var purged []int64
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		purged, err = uc.repo.PurgeDeleted(ctx, time.Now().Add(-uc.retention), uc.batchSize)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// Run очищает доски раз в interval, пока не отменен ctx
+func (uc *PurgeDeletedBoardsUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Пока пачки приходят полными, чистим без пауз
+		for {
+			purged, err := uc.Handle(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to purge deleted boards")
+				break
+			}
+
+			if len(purged) > 0 {
+				log.Info().Ints64("board_ids", purged).Msg("deleted boards purged")
+			}
+
+			if len(purged) < uc.batchSize {
+				break
+			}
+		}
+	}
+}
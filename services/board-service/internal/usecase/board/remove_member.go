@@ -0,0 +1,54 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+	"Taskify/services/board-service/internal/identity"
+)
+
+type RemoveMemberUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	memberRepo board.MemberRepository
+	auth       *Authorizer
+}
+
+func NewRemoveMemberUseCase(tx TxManager, boardRepo board.Repository, memberRepo board.MemberRepository, auth *Authorizer) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{tx: tx, boardRepo: boardRepo, memberRepo: memberRepo, auth: auth}
+}
+
+// Handle убирает участника с доски. Любой участник может уйти сам,
+// убрать другого может только тот, кто умеет выдавать его роль.
+// Владельца убрать нельзя — сначала нужно передать доску.
+func (uc *RemoveMemberUseCase) Handle(ctx context.Context, cmd RemoveMemberCommand) error {
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		callerRole, err := uc.auth.Role(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		member, err := uc.memberRepo.Get(ctx, cmd.BoardID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if member.Role == board.RoleOwner {
+			return board.ErrOwnerRoleImmutable
+		}
+
+		callerID, _ := identity.UserID(ctx)
+		if member.UserID != callerID {
+			if !callerRole.Allows(board.PermissionManageMembers) || !callerRole.CanAssign(member.Role) {
+				return board.ErrForbidden
+			}
+		}
+
+		return uc.memberRepo.Delete(ctx, cmd.BoardID, cmd.UserID)
+	})
+}
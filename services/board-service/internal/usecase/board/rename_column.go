@@ -0,0 +1,59 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type RenameColumnUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewRenameColumnUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *RenameColumnUseCase {
+	return &RenameColumnUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *RenameColumnUseCase) Handle(ctx context.Context, cmd RenameColumnCommand) (*board.Column, error) {
+	var renamed *board.Column
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Update перезаписывает и позицию, так что не даем ему разойтись с MoveColumn
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		column, err := getBoardColumn(ctx, uc.columnRepo, cmd.BoardID, cmd.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		if err := column.Rename(cmd.Title); err != nil {
+			return err
+		}
+
+		renamed, err = uc.columnRepo.Update(ctx, column)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewColumnRenamed(renamed))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return renamed, nil
+}
@@ -0,0 +1,56 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// RestoreBoardUseCase возвращает удаленную доску, пока её не очистил PurgeDeletedBoardsUseCase
+type RestoreBoardUseCase struct {
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
+}
+
+func NewRestoreBoardUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *RestoreBoardUseCase {
+	return &RestoreBoardUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *RestoreBoardUseCase) Handle(ctx context.Context, id int64) (*board.Board, error) {
+	var restored *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Блокировка не дает очистке удалить доску, пока мы её восстанавливаем
+		b, err := uc.repo.GetAnyByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// Восстановить может тот, кто мог удалить
+		if err := uc.auth.Authorize(ctx, b, board.PermissionDelete); err != nil {
+			return err
+		}
+
+		if err := b.Restore(time.Now()); err != nil {
+			return err
+		}
+
+		restored, err = uc.repo.Update(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardRestored(restored))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, id)
+
+	return restored, nil
+}
@@ -0,0 +1,54 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type RevokeInvitationUseCase struct {
+	tx             TxManager
+	boardRepo      board.Repository
+	invitationRepo board.InvitationRepository
+	auth           *Authorizer
+}
+
+func NewRevokeInvitationUseCase(tx TxManager, boardRepo board.Repository, invitationRepo board.InvitationRepository, auth *Authorizer) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{tx: tx, boardRepo: boardRepo, invitationRepo: invitationRepo, auth: auth}
+}
+
+// Handle отзывает действующее приглашение: токен из письма больше не сработает
+func (uc *RevokeInvitationUseCase) Handle(ctx context.Context, cmd RevokeInvitationCommand) error {
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		callerRole, err := managerRole(ctx, uc.auth, b)
+		if err != nil {
+			return err
+		}
+
+		invitation, err := uc.invitationRepo.GetByID(ctx, cmd.InvitationID)
+		if err != nil {
+			return err
+		}
+
+		// Приглашение другой доски для этой доски не существует
+		if invitation.BoardID != cmd.BoardID {
+			return board.ErrInvitationNotFound
+		}
+
+		if !callerRole.CanAssign(invitation.Role) {
+			return board.ErrForbidden
+		}
+
+		if err := invitation.Revoke(time.Now()); err != nil {
+			return err
+		}
+
+		return uc.invitationRepo.Update(ctx, invitation)
+	})
+}
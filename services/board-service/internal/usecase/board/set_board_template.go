@@ -0,0 +1,58 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// SetBoardTemplateUseCase отмечает доску как шаблон или снимает отметку
+type SetBoardTemplateUseCase struct {
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
+}
+
+func NewSetBoardTemplateUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *SetBoardTemplateUseCase {
+	return &SetBoardTemplateUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *SetBoardTemplateUseCase) Handle(ctx context.Context, cmd SetBoardTemplateCommand) (*board.Board, error) {
+	var updated *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.repo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		// Повторная отметка ничего не меняет и не поднимает версию
+		if b.IsTemplate == cmd.IsTemplate {
+			updated = b
+			return nil
+		}
+
+		b.SetTemplate(cmd.IsTemplate, time.Now())
+
+		updated, err = uc.repo.Update(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardUpdated(updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return updated, nil
+}
@@ -0,0 +1,25 @@
+package board
+
+import (
+	"context"
+	"errors"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// getBoardTask возвращает задачу, только если её колонка принадлежит указанной доске
+func getBoardTask(ctx context.Context, taskRepo board.TaskRepository, columnRepo board.ColumnRepository, boardID, taskID int64) (*board.Task, error) {
+	task, err := taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := getBoardColumn(ctx, columnRepo, boardID, task.ColumnID); err != nil {
+		if errors.Is(err, board.ErrColumnNotFound) {
+			return nil, board.ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	return task, nil
+}
@@ -0,0 +1,173 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestCreateTaskAppendsToColumn(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0", "a1"}}, "A", "B")
+
+	uc := NewCreateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+	for i, column := range columns {
+		task, err := uc.Handle(as(1), CreateTaskCommand{BoardID: b.ID, ColumnID: column.ID, Title: "new", AssigneeID: 1})
+		if err != nil {
+			t.Fatalf("Handle: %v", err)
+		}
+		if want := []int{2, 0}[i]; task.Position != want {
+			t.Errorf("column %d: position = %d, want %d", i, task.Position, want)
+		}
+	}
+
+	if got, dense := f.taskTitles(columns[0].ID); !slices.Equal(got, []string{"a0", "a1", "new"}) || !dense {
+		t.Errorf("column A = %v (dense %v)", got, dense)
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	title, empty, description := "Renamed", "", "Details"
+	unassign, assignee, unknown := int64(0), int64(2), int64(3)
+
+	tests := []struct {
+		name string
+		cmd  UpdateTaskCommand
+		want board.Task
+		err  error
+	}{
+		{name: "nothing", want: board.Task{Title: "a0", AssigneeID: 1}},
+		{name: "title", cmd: UpdateTaskCommand{Title: &title}, want: board.Task{Title: "Renamed", AssigneeID: 1}},
+		{name: "description", cmd: UpdateTaskCommand{Description: &description}, want: board.Task{Title: "a0", Description: "Details", AssigneeID: 1}},
+		{name: "reassign", cmd: UpdateTaskCommand{AssigneeID: &assignee}, want: board.Task{Title: "a0", AssigneeID: 2}},
+		{name: "unassign", cmd: UpdateTaskCommand{AssigneeID: &unassign}, want: board.Task{Title: "a0"}},
+		{name: "unknown assignee", cmd: UpdateTaskCommand{AssigneeID: &unknown}, want: board.Task{Title: "a0", AssigneeID: 1}, err: board.ErrAssigneeNotFound},
+		{name: "empty title", cmd: UpdateTaskCommand{Title: &empty, AssigneeID: &assignee}, want: board.Task{Title: "a0", AssigneeID: 1}, err: board.ErrTaskTitleRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0", "a1"}}, "A")
+
+			// Пользователя 3 нет: назначить его нельзя
+			f.store.ensureUser(2)
+
+			task := f.store.columnTasks(columns[0].ID)[0]
+			task.AssigneeID = 1
+			if _, err := f.tasks.Update(as(1), &task); err != nil {
+				t.Fatalf("assign: %v", err)
+			}
+
+			tt.cmd.BoardID, tt.cmd.TaskID = b.ID, task.ID
+			_, err := NewUpdateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth).Handle(as(1), tt.cmd)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			// Позиция и колонка через UpdateTask не меняются
+			got := f.store.columnTasks(columns[0].ID)[0]
+			if got.ID != task.ID || got.Position != 0 {
+				t.Fatalf("task %d moved to position %d", got.ID, got.Position)
+			}
+			if got.Title != tt.want.Title || got.Description != tt.want.Description || got.AssigneeID != tt.want.AssigneeID {
+				t.Errorf("task = %q/%q/%d, want %q/%q/%d", got.Title, got.Description, got.AssigneeID, tt.want.Title, tt.want.Description, tt.want.AssigneeID)
+			}
+		})
+	}
+}
+
+func TestDeleteTaskClosesGap(t *testing.T) {
+	tests := []struct {
+		name string
+		task string
+		want []string
+	}{
+		{name: "first", task: "a0", want: []string{"a1", "a2"}},
+		{name: "middle", task: "a1", want: []string{"a0", "a2"}},
+		{name: "last", task: "a2", want: []string{"a0", "a1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0", "a1", "a2"}}, "A")
+
+			var taskID int64
+			for _, task := range f.store.columnTasks(columns[0].ID) {
+				if task.Title == tt.task {
+					taskID = task.ID
+				}
+			}
+
+			uc := NewDeleteTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+			if err := uc.Handle(as(1), DeleteTaskCommand{BoardID: b.ID, TaskID: taskID}); err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+
+			if got, dense := f.taskTitles(columns[0].ID); !slices.Equal(got, tt.want) || !dense {
+				t.Errorf("column A = %v (dense %v), want %v", got, dense, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskUseCasesErrors(t *testing.T) {
+	f := newFixture()
+	b, columns := f.seedBoard(1, "Board", map[string][]string{"A": {"a0"}}, "A")
+	_, foreign := f.seedBoard(1, "Other", map[string][]string{"X": {"x0"}}, "X")
+	foreignTask := f.store.columnTasks(foreign[0].ID)[0]
+
+	f.store.ensureUser(2)
+	if err := f.members.Create(as(1), &board.Member{BoardID: b.ID, UserID: 2, Role: board.RoleViewer}); err != nil {
+		t.Fatalf("add viewer: %v", err)
+	}
+
+	create := NewCreateTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+	get := NewGetTaskUseCase(f.boards, f.columns, f.tasks, f.auth)
+	remove := NewDeleteTaskUseCase(f.store, f.boards, f.columns, f.tasks, f.outbox, f.cache, f.auth)
+
+	tests := []struct {
+		name string
+		call func() error
+		err  error
+	}{
+		{name: "create in column of another board", call: func() error {
+			_, err := create.Handle(as(1), CreateTaskCommand{BoardID: b.ID, ColumnID: foreign[0].ID, Title: "t"})
+			return err
+		}, err: board.ErrColumnNotFound},
+		{name: "create without title", call: func() error {
+			_, err := create.Handle(as(1), CreateTaskCommand{BoardID: b.ID, ColumnID: columns[0].ID})
+			return err
+		}, err: board.ErrTaskTitleRequired},
+		{name: "create by viewer", call: func() error {
+			_, err := create.Handle(as(2), CreateTaskCommand{BoardID: b.ID, ColumnID: columns[0].ID, Title: "t"})
+			return err
+		}, err: board.ErrForbidden},
+		{name: "get task of another board", call: func() error {
+			_, err := get.Handle(as(1), GetTaskQuery{BoardID: b.ID, TaskID: foreignTask.ID})
+			return err
+		}, err: board.ErrTaskNotFound},
+		{name: "get missing task", call: func() error {
+			_, err := get.Handle(as(2), GetTaskQuery{BoardID: b.ID, TaskID: 999})
+			return err
+		}, err: board.ErrTaskNotFound},
+		{name: "delete task of another board", call: func() error {
+			return remove.Handle(as(1), DeleteTaskCommand{BoardID: b.ID, TaskID: foreignTask.ID})
+		}, err: board.ErrTaskNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if got, _ := f.taskTitles(columns[0].ID); !slices.Equal(got, []string{"a0"}) {
+		t.Errorf("column A = %v after rejected commands, want [a0]", got)
+	}
+}
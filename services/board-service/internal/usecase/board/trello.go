@@ -0,0 +1,67 @@
+package board
+
+import (
+	"encoding/json"
+	"sort"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+// trelloExport — часть экспорта доски Trello (Menu → Print and export → JSON),
+// которую мы переносим. Остальные поля экспорта игнорируются.
+type trelloExport struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Desc       string            `json:"desc"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Members    []trelloMember    `json:"members"`
+	Checklists []trelloChecklist `json:"checklists"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Desc      string   `json:"desc"`
+	Closed    bool     `json:"closed"`
+	IDList    string   `json:"idList"`
+	Pos       float64  `json:"pos"`
+	IDMembers []string `json:"idMembers"`
+}
+
+type trelloMember struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+type trelloChecklist struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	IDCard string `json:"idCard"`
+}
+
+func parseTrelloExport(data []byte) (*trelloExport, error) {
+	var export trelloExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, board.ErrInvalidImport
+	}
+
+	// Пустой объект или JSON не от Trello: без id и списков переносить нечего
+	if export.ID == "" && export.Lists == nil {
+		return nil, board.ErrInvalidImport
+	}
+
+	// В Trello порядок задает pos, а не порядок в массиве
+	sort.SliceStable(export.Lists, func(i, j int) bool { return export.Lists[i].Pos < export.Lists[j].Pos })
+	sort.SliceStable(export.Cards, func(i, j int) bool { return export.Cards[i].Pos < export.Cards[j].Pos })
+
+	return &export, nil
+}
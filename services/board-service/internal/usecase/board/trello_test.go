@@ -0,0 +1,212 @@
+package board
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestParseTrelloExport(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		lists []string
+		cards []string
+		err   error
+	}{
+		{
+			name:  "lists and cards by pos",
+			data:  `{"id":"b1","name":"Board","lists":[{"id":"l2","name":"Done","pos":2},{"id":"l1","name":"Todo","pos":1}],"cards":[{"id":"c2","pos":20},{"id":"c1","pos":10},{"id":"c3","pos":10.5}]}`,
+			lists: []string{"l1", "l2"},
+			cards: []string{"c1", "c3", "c2"},
+		},
+		{
+			// Равные pos сохраняют порядок массива
+			name:  "equal pos",
+			data:  `{"id":"b1","lists":[{"id":"l1","pos":1},{"id":"l2","pos":1}]}`,
+			lists: []string{"l1", "l2"},
+		},
+		{
+			name:  "unknown fields are ignored",
+			data:  `{"id":"b1","labels":[{"id":"x"}],"prefs":{"background":"blue"},"lists":[]}`,
+			lists: []string{},
+		},
+		{name: "board without lists", data: `{"id":"b1","name":"Board"}`},
+		{name: "lists without board id", data: `{"lists":[{"id":"l1"}]}`, lists: []string{"l1"}},
+		{name: "empty object", data: `{}`, err: board.ErrInvalidImport},
+		{name: "not json", data: `name,lists`, err: board.ErrInvalidImport},
+		{name: "array", data: `[{"id":"b1"}]`, err: board.ErrInvalidImport},
+		{name: "wrong field type", data: `{"id":"b1","lists":{"id":"l1"}}`, err: board.ErrInvalidImport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := parseTrelloExport([]byte(tt.data))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			var lists, cards []string
+			for _, l := range export.Lists {
+				lists = append(lists, l.ID)
+			}
+			for _, c := range export.Cards {
+				cards = append(cards, c.ID)
+			}
+			if !slices.Equal(lists, tt.lists) || !slices.Equal(cards, tt.cards) {
+				t.Errorf("lists = %v, cards = %v, want %v, %v", lists, cards, tt.lists, tt.cards)
+			}
+		})
+	}
+}
+
+func TestFitTitle(t *testing.T) {
+	tests := []struct {
+		name      string
+		title     string
+		limit     int
+		want      string
+		truncated bool
+	}{
+		{name: "short", title: "Todo", limit: 5, want: "Todo"},
+		{name: "at the limit", title: "Todos", limit: 5, want: "Todos"},
+		{name: "too long", title: "Todo list", limit: 5, want: "Todo ", truncated: true},
+		// Обрезка идет по символам, а не по байтам
+		{name: "cyrillic", title: "Сделать", limit: 3, want: "Сде", truncated: true},
+		{name: "empty", title: "", limit: 3, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated := fitTitle(tt.title, tt.limit)
+			if got != tt.want || truncated != tt.truncated {
+				t.Errorf("fitTitle(%q, %d) = %q, %v, want %q, %v", tt.title, tt.limit, got, truncated, tt.want, tt.truncated)
+			}
+		})
+	}
+}
+
+func TestImportTrelloBoard(t *testing.T) {
+	longCard := strings.Repeat("к", board.MaxTaskTitleLength+10)
+
+	export := `{
+		"id": "b1", "name": "Trello board", "desc": "From Trello",
+		"lists": [
+			{"id": "l2", "name": "Done", "pos": 2},
+			{"id": "l1", "name": "Todo", "pos": 1},
+			{"id": "l3", "name": "Old", "pos": 3, "closed": true}
+		],
+		"cards": [
+			{"id": "c2", "name": "second", "idList": "l1", "pos": 2, "idMembers": ["m-bob", "m-eve"]},
+			{"id": "c1", "name": "first", "idList": "l1", "pos": 1, "idMembers": ["m-ghost"]},
+			{"id": "c3", "name": "done", "idList": "l2", "pos": 1},
+			{"id": "c4", "name": "archived", "idList": "l1", "pos": 3, "closed": true},
+			{"id": "c5", "name": "in closed list", "idList": "l3", "pos": 1},
+			{"id": "c6", "name": "` + longCard + `", "idList": "l2", "pos": 2}
+		],
+		"members": [
+			{"id": "m-owner", "username": "alice"},
+			{"id": "m-bob", "username": "Bob"},
+			{"id": "m-eve", "username": "eve"},
+			{"id": "m-ghost", "username": "ghost"}
+		],
+		"checklists": [{"id": "ch1", "name": "Steps", "idCard": "c1"}]
+	}`
+
+	f := newFixture()
+	f.store.addUser(ownerID, "alice")
+	f.store.addUser(2, "bob")
+	f.store.addUser(3, "eve")
+	tokens, mailer := &seqTokens{}, &memoryMailer{}
+
+	uc := NewImportTrelloBoardUseCase(f.store, f.boards, f.columns, f.tasks, f.members, f.users, f.outbox, NewImportInviter(f.invitations, f.users, tokens, mailer, time.Hour))
+	report, err := uc.Handle(as(ownerID), ImportTrelloBoardCommand{Export: []byte(export), OwnerID: ownerID})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	b := report.Board
+	if b.Title != "Trello board" || b.Description != "From Trello" || b.Owner != ownerID {
+		t.Errorf("board = %+v", b)
+	}
+	if report.Columns != 2 || report.Tasks != 4 || report.Invitations != 2 {
+		t.Errorf("report = %d columns, %d tasks, %d invitations, want 2, 4, 2", report.Columns, report.Tasks, report.Invitations)
+	}
+	if titles, _ := f.columnTitles(b.ID); !slices.Equal(titles, []string{"Todo", "Done"}) {
+		t.Errorf("columns = %v, want [Todo Done]", titles)
+	}
+
+	columns, _ := f.columns.ListByBoard(as(ownerID), b.ID)
+	todo, done := f.store.columnTasks(columns[0].ID), f.store.columnTasks(columns[1].ID)
+	if len(todo) != 2 || todo[0].Title != "first" || todo[1].Title != "second" {
+		t.Fatalf("todo = %+v, want first, second", todo)
+	}
+	// Исполнитель — первый найденный участник карточки
+	if todo[0].AssigneeID != 0 || todo[1].AssigneeID != 2 {
+		t.Errorf("assignees = %d, %d, want 0, 2", todo[0].AssigneeID, todo[1].AssigneeID)
+	}
+	if len(done) != 2 || done[0].Title != "done" || len([]rune(done[1].Title)) != board.MaxTaskTitleLength {
+		t.Errorf("done = %+v", done)
+	}
+
+	// Найденные пользователи только приглашены, участник доски — один владелец
+	members, _ := f.members.ListByBoard(as(ownerID), b.ID)
+	if len(members) != 1 {
+		t.Errorf("members = %+v, want only the owner", members)
+	}
+	var invited []string
+	for _, inv := range f.store.pendingInvitations(b.ID) {
+		invited = append(invited, inv.Email)
+	}
+	if !slices.Equal(invited, []string{"bob@example.com", "eve@example.com"}) || len(mailer.sent) != 2 {
+		t.Errorf("invitations = %v, letters = %v", invited, mailer.sent)
+	}
+
+	var issues []string
+	for _, issue := range report.Issues {
+		issues = append(issues, issue.Kind+":"+issue.SourceID)
+	}
+	// Карточки в отчете идут в порядке pos, как их разбирает импорт
+	want := []string{"member:m-ghost", "list:l3", "card:c5", "card:c2", "card:c6", "card:c4", "checklist:ch1"}
+	if !slices.Equal(issues, want) {
+		t.Errorf("issues = %v, want %v", issues, want)
+	}
+
+	if got := f.store.eventTypes(); !slices.Equal(got, []board.EventType{board.EventBoardCreated, board.EventBoardImported}) {
+		t.Errorf("events = %v", got)
+	}
+}
+
+func TestImportTrelloBoardErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		export string
+		err    error
+	}{
+		{name: "not a trello export", export: `{"boards":[]}`, err: board.ErrInvalidImport},
+		{name: "board without name", export: `{"id":"b1","name":"","lists":[]}`, err: board.ErrTitleRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			f.store.ensureUser(ownerID)
+
+			uc := NewImportTrelloBoardUseCase(f.store, f.boards, f.columns, f.tasks, f.members, f.users, f.outbox, NewImportInviter(f.invitations, f.users, &seqTokens{}, &memoryMailer{}, time.Hour))
+			_, err := uc.Handle(as(ownerID), ImportTrelloBoardCommand{Export: []byte(tt.export), OwnerID: ownerID})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(f.store.boards) != 0 || len(f.store.events) != 0 {
+				t.Errorf("boards = %d, events = %v after failed import", len(f.store.boards), f.store.eventTypes())
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package board
+
+import "context"
+
+// TxManager выполняет несколько обращений к репозиториям атомарно
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinReadOnlyTransaction — транзакция только на чтение, в которой все запросы
+	// видят один согласованный снимок данных
+	WithinReadOnlyTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,53 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type UnarchiveBoardUseCase struct {
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
+}
+
+func NewUnarchiveBoardUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *UnarchiveBoardUseCase {
+	return &UnarchiveBoardUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
+}
+
+func (uc *UnarchiveBoardUseCase) Handle(ctx context.Context, id int64) (*board.Board, error) {
+	var unarchived *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.repo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionArchive); err != nil {
+			return err
+		}
+
+		if err := b.Unarchive(time.Now()); err != nil {
+			return err
+		}
+
+		unarchived, err = uc.repo.Update(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewBoardUnarchived(unarchived))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, id)
+
+	return unarchived, nil
+}
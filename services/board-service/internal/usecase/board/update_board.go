@@ -11,46 +11,71 @@ import (
 )
 
 type UpdateBoardUseCase struct {
-	repo board.Repository
+	tx     TxManager
+	repo   board.Repository
+	outbox Outbox
+	cache  BoardCache
+	auth   *Authorizer
 }
 
-func NewUpdateBoardUseCase(repo board.Repository) *UpdateBoardUseCase {
-	return &UpdateBoardUseCase{repo: repo}
+func NewUpdateBoardUseCase(tx TxManager, repo board.Repository, outbox Outbox, cache BoardCache, auth *Authorizer) *UpdateBoardUseCase {
+	return &UpdateBoardUseCase{tx: tx, repo: repo, outbox: outbox, cache: cache, auth: auth}
 }
 
 func (uc *UpdateBoardUseCase) Handle(ctx context.Context, cmd UpdateBoardCommand) (*board.Board, error) {
-	// 1. Сначала получаем текущую доску, чтобы убедиться, что она существует
-	currentBoard, err := uc.repo.GetByID(ctx, cmd.ID)
-	if err != nil {
-		return nil, err
-	}
+	var updatedBoard *board.Board
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// 1. Сначала получаем текущую доску, чтобы убедиться, что она существует.
+		// Блокируем её, чтобы не затереть параллельную передачу владельца
+		currentBoard, err := uc.repo.GetByIDForUpdate(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
 
-	log.Debug().Msgf("current board: %v", *currentBoard)
+		if err := uc.auth.Authorize(ctx, currentBoard, board.PermissionEdit); err != nil {
+			return err
+		}
 
-	// 2. Применяем изменения к доменной сущности (в памяти)
-	if cmd.Title != nil {
-		if *cmd.Title == "" {
-			return nil, domain.ErrTitleRequired
+		// Клиент правил устаревшую версию — его изменения затерли бы чужие
+		if cmd.ExpectedVersion != nil && *cmd.ExpectedVersion != currentBoard.Version {
+			return board.ErrVersionConflict
 		}
-		currentBoard.Title = *cmd.Title
-	}
 
-	if cmd.Description != nil {
-		currentBoard.Description = *cmd.Description
-	}
+		log.Debug().Msgf("current board: %v", *currentBoard)
 
-	// Обновляем время
-	currentBoard.UpdatedAt = time.Now()
+		// 2. Применяем изменения к доменной сущности (в памяти)
+		if cmd.Title != nil {
+			if *cmd.Title == "" {
+				return domain.ErrTitleRequired
+			}
+			currentBoard.Title = *cmd.Title
+		}
+
+		if cmd.Description != nil {
+			currentBoard.Description = *cmd.Description
+		}
+
+		// Обновляем время
+		currentBoard.UpdatedAt = time.Now()
+
+		log.Debug().Msgf("board data to update: %v", *currentBoard)
+
+		// 3. Сохраняем обновленную сущность
+		updatedBoard, err = uc.repo.Update(ctx, currentBoard)
+		if err != nil {
+			return err
+		}
 
-	log.Debug().Msgf("board data to update: %v", *currentBoard)
+		log.Debug().Msgf("updated board: %v", *updatedBoard)
 
-	// 3. Сохраняем обновленную сущность
-	updatedBoard, err := uc.repo.Update(ctx, currentBoard)
+		return uc.outbox.Save(ctx, board.NewBoardUpdated(updatedBoard))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	log.Debug().Msgf("updated board: %v", *updatedBoard)
+	invalidateBoard(ctx, uc.cache, cmd.ID)
 
 	return updatedBoard, nil
 }
@@ -0,0 +1,50 @@
+package board
+
+import (
+	"errors"
+	"testing"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+func TestUpdateBoardVersion(t *testing.T) {
+	version := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		expected *int64
+		err      error
+		// title и version — доска после запроса
+		title   string
+		version int64
+	}{
+		{name: "without check", expected: nil, title: "Renamed", version: 3},
+		{name: "current version", expected: version(2), title: "Renamed", version: 3},
+		{name: "stale version", expected: version(1), err: board.ErrVersionConflict, title: "Board", version: 2},
+		{name: "version from the future", expected: version(5), err: board.ErrVersionConflict, title: "Board", version: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			b, _ := f.seedBoard(ownerID, "Board", nil)
+			f.store.updateBoard(b.ID, func(b *board.Board) { b.Version = 2 })
+
+			title := "Renamed"
+			uc := NewUpdateBoardUseCase(f.store, f.boards, f.outbox, f.cache, f.auth)
+			updated, err := uc.Handle(as(ownerID), UpdateBoardCommand{ID: b.ID, Title: &title, ExpectedVersion: tt.expected})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			// Ответ несет новую версию: по ней клиент строит следующий If-Match
+			if err == nil && updated.Version != tt.version {
+				t.Errorf("returned version = %d, want %d", updated.Version, tt.version)
+			}
+
+			stored, _ := f.boards.GetByID(as(ownerID), b.ID)
+			if stored.Title != tt.title || stored.Version != tt.version {
+				t.Errorf("board = %q v%d, want %q v%d", stored.Title, stored.Version, tt.title, tt.version)
+			}
+		})
+	}
+}
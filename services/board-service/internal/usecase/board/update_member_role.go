@@ -0,0 +1,63 @@
+package board
+
+import (
+	"context"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type UpdateMemberRoleUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	memberRepo board.MemberRepository
+	auth       *Authorizer
+}
+
+func NewUpdateMemberRoleUseCase(tx TxManager, boardRepo board.Repository, memberRepo board.MemberRepository, auth *Authorizer) *UpdateMemberRoleUseCase {
+	return &UpdateMemberRoleUseCase{tx: tx, boardRepo: boardRepo, memberRepo: memberRepo, auth: auth}
+}
+
+// Handle меняет роль участника. Вызывающий должен уметь выдавать
+// и текущую роль участника, и новую — так админ не тронет другого админа.
+func (uc *UpdateMemberRoleUseCase) Handle(ctx context.Context, cmd UpdateMemberRoleCommand) (*board.Member, error) {
+	role, err := board.ParseRole(cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *board.Member
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		callerRole, err := managerRole(ctx, uc.auth, b)
+		if err != nil {
+			return err
+		}
+
+		member, err := uc.memberRepo.Get(ctx, cmd.BoardID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		previous := member.Role
+		if err := member.ChangeRole(role); err != nil {
+			return err
+		}
+
+		if !callerRole.CanAssign(previous) || !callerRole.CanAssign(role) {
+			return board.ErrForbidden
+		}
+
+		updated, err = uc.memberRepo.Update(ctx, member)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
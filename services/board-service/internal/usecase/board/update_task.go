@@ -0,0 +1,75 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"Taskify/services/board-service/internal/domain/board"
+)
+
+type UpdateTaskUseCase struct {
+	tx         TxManager
+	boardRepo  board.Repository
+	columnRepo board.ColumnRepository
+	taskRepo   board.TaskRepository
+	outbox     Outbox
+	cache      BoardCache
+	auth       *Authorizer
+}
+
+func NewUpdateTaskUseCase(tx TxManager, boardRepo board.Repository, columnRepo board.ColumnRepository, taskRepo board.TaskRepository, outbox Outbox, cache BoardCache, auth *Authorizer) *UpdateTaskUseCase {
+	return &UpdateTaskUseCase{tx: tx, boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo, outbox: outbox, cache: cache, auth: auth}
+}
+
+// Handle меняет содержимое задачи. Колонка и позиция меняются только через MoveTask.
+func (uc *UpdateTaskUseCase) Handle(ctx context.Context, cmd UpdateTaskCommand) (*board.Task, error) {
+	var updated *board.Task
+
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Update перезаписывает и позицию, поэтому читаем задачу под той же блокировкой,
+		// что и MoveTask — иначе можно затереть результат параллельного перемещения
+		b, err := uc.boardRepo.GetByIDForUpdate(ctx, cmd.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.auth.Authorize(ctx, b, board.PermissionEdit); err != nil {
+			return err
+		}
+
+		task, err := getBoardTask(ctx, uc.taskRepo, uc.columnRepo, cmd.BoardID, cmd.TaskID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Title != nil {
+			if err := task.Rename(*cmd.Title); err != nil {
+				return err
+			}
+		}
+
+		if cmd.Description != nil {
+			task.Description = *cmd.Description
+		}
+
+		if cmd.AssigneeID != nil {
+			task.Assign(*cmd.AssigneeID)
+		}
+
+		task.UpdatedAt = time.Now()
+
+		updated, err = uc.taskRepo.Update(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		return uc.outbox.Save(ctx, board.NewTaskUpdated(b, updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateBoard(ctx, uc.cache, cmd.BoardID)
+
+	return updated, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	pb "Taskify/proto/notifications/v1"
+
+	"Taskify/services/notification-service/internal/config"
+	"Taskify/services/notification-service/internal/infrastructure/kafka"
+	"Taskify/services/notification-service/internal/infrastructure/mailer"
+	"Taskify/services/notification-service/internal/infrastructure/persistence"
+	grpcHandler "Taskify/services/notification-service/internal/transport/grpc"
+	usecaseNotification "Taskify/services/notification-service/internal/usecase/notification"
+)
+
+func main() {
+	// Контекст отменяется по SIGINT/SIGTERM: консьюмер успевает дообработать сообщение
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serviceConfig := config.MustLoad()
+
+	setupLogger(serviceConfig.Env)
+
+	// 1. Подключение к БД
+	dbPool, err := pgxpool.New(ctx, serviceConfig.Postgres.URL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Unable to connect to DB")
+	}
+	defer dbPool.Close()
+
+	if err := dbPool.Ping(ctx); err != nil {
+		log.Fatal().Err(err).Msg("DB Ping failed")
+	}
+	log.Printf("Successfully connected to Database")
+
+	// 2. Инициализация слоев
+	notificationRepo := persistence.NewNotificationRepository(dbPool)
+	processedRepo := persistence.NewProcessedEventRepository(dbPool)
+	txManager := persistence.NewTxManager(dbPool)
+
+	handleEventUC := usecaseNotification.NewHandleEventUseCase(txManager, notificationRepo, processedRepo, mailer.NewLogSender())
+	listNotificationsUC := usecaseNotification.NewListNotificationsUseCase(notificationRepo)
+
+	// 3. Консьюмер событий досок
+	consumer := kafka.NewConsumer(
+		serviceConfig.Kafka.Brokers,
+		serviceConfig.Kafka.BoardEventsTopic,
+		serviceConfig.Kafka.GroupID,
+		handleEventUC,
+		serviceConfig.Kafka.RetryBackoff,
+	)
+	defer consumer.Close()
+
+	go consumer.Run(ctx)
+
+	// 4. gRPC сервер с историей уведомлений
+	lis, err := net.Listen("tcp", serviceConfig.GRPC.Port)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to listen")
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcHandler.IdentityInterceptor()))
+	pb.RegisterNotificationServiceServer(grpcServer, grpcHandler.NewHandler(listNotificationsUC))
+	reflection.Register(grpcServer)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("Notification Service (gRPC) is running on port %s", serviceConfig.GRPC.Port)
+
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatal().Err(err).Msg("Failed to serve gRPC")
+	}
+}
+
+func setupLogger(env string) {
+	switch env {
+	case "local":
+		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "15:04:05"})
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "dev":
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	case "prod":
+		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type Config struct {
+	Env      string `yaml:"env" env:"ENV" env-default:"local"` // local, dev, prod
+	Postgres PostgresConfig
+	Kafka    KafkaConfig
+	GRPC     GRPCConfig
+}
+
+type PostgresConfig struct {
+	URL string `env:"DATABASE_URL" env-required:"true"`
+}
+
+type KafkaConfig struct {
+	Brokers          []string `env:"KAFKA_BROKERS" env-separator:"," env-default:"localhost:9092"`
+	BoardEventsTopic string   `env:"KAFKA_BOARD_EVENTS_TOPIC" env-default:"board-events"`
+	GroupID          string   `env:"KAFKA_CONSUMER_GROUP" env-default:"notification-service"`
+	// Пауза перед повторной обработкой события после временной ошибки
+	RetryBackoff time.Duration `env:"KAFKA_RETRY_BACKOFF" env-default:"1s"`
+}
+
+type GRPCConfig struct {
+	Port string `env:"GRPC_PORT" env-default:":50052"`
+}
+
+func MustLoad() *Config {
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "./config/notification.env" // Дефолт для локальной разработки
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		log.Printf("Config file %s not found, reading from ENV variables", configPath)
+	}
+
+	var cfg Config
+	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
+		if err := cleanenv.ReadEnv(&cfg); err != nil {
+			log.Fatalf("cannot read config: %s", err)
+		}
+	}
+
+	return &cfg
+}
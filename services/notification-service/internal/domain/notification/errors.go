@@ -0,0 +1,8 @@
+package notification
+
+import "errors"
+
+var (
+	ErrMalformedEvent = errors.New("malformed event")
+	ErrEmptyUser      = errors.New("user is empty")
+)
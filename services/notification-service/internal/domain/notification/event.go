@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы событий Board Service, на которые отправляются уведомления
+const (
+	EventTaskCreated = "TaskCreated"
+	EventTaskUpdated = "TaskUpdated"
+	EventTaskMoved   = "TaskMoved"
+)
+
+// Event — событие доски из топика. ID уникален для события и не меняется
+// при повторной доставке, по нему обработка сделана идемпотентной.
+type Event struct {
+	ID         string
+	Type       string
+	BoardID    int64
+	OccurredAt time.Time
+	Payload    json.RawMessage
+}
+
+// TaskPayload — поля задачи из событий Task*, нужные для уведомлений
+type TaskPayload struct {
+	BoardID      int64  `json:"boardId"`
+	BoardOwnerID int64  `json:"boardOwnerId"`
+	TaskID       int64  `json:"taskId"`
+	Title        string `json:"title"`
+	AssigneeID   int64  `json:"assigneeId"`
+}
@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var eventIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Notification — запись о том, что пользователю "отправлено" письмо о событии
+type Notification struct {
+	ID        int64
+	EventID   string
+	UserID    int64
+	BoardID   int64
+	TaskID    int64
+	EventType string
+	Message   string
+	CreatedAt time.Time
+}
+
+func NewNotification(e Event, userID, taskID int64, message string) (*Notification, error) {
+	if userID == 0 {
+		return nil, ErrEmptyUser
+	}
+
+	return &Notification{
+		EventID:   e.ID,
+		UserID:    userID,
+		BoardID:   e.BoardID,
+		TaskID:    taskID,
+		EventType: e.Type,
+		Message:   message,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// FromEvent решает, кого и о чем уведомить. Уведомляются исполнитель задачи
+// и владелец доски, каждый не больше одного раза. Для событий, о которых
+// не уведомляют, возвращается пустой список.
+func FromEvent(e Event) ([]*Notification, error) {
+	// id события — UUID из outbox_events, по нему ведется журнал обработанных
+	if !eventIDPattern.MatchString(e.ID) {
+		return nil, fmt.Errorf("%w: invalid event id %q", ErrMalformedEvent, e.ID)
+	}
+
+	var action string
+
+	switch e.Type {
+	case EventTaskCreated:
+		action = "created"
+	case EventTaskUpdated:
+		action = "updated"
+	case EventTaskMoved:
+		action = "moved"
+	default:
+		return nil, nil
+	}
+
+	var payload TaskPayload
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedEvent, err)
+	}
+
+	message := fmt.Sprintf("task %q %s", payload.Title, action)
+
+	notifications := make([]*Notification, 0, 2)
+	seen := make(map[int64]bool, 2)
+
+	for _, userID := range []int64{payload.AssigneeID, payload.BoardOwnerID} {
+		if userID == 0 || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		n, err := NewNotification(e, userID, payload.TaskID, message)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, n)
+	}
+
+	return notifications, nil
+}
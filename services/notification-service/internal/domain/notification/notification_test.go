@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+)
+
+const eventID = "0b9f6a3e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"
+
+func TestFromEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		typ     string
+		payload string
+		users   []int64
+		message string
+		err     error
+	}{
+		{
+			name:    "created: assignee and owner",
+			typ:     EventTaskCreated,
+			payload: `{"boardId":1,"boardOwnerId":7,"taskId":3,"title":"Docs","assigneeId":8}`,
+			users:   []int64{8, 7},
+			message: `task "Docs" created`,
+		},
+		{
+			name:    "updated: owner assigned to own task",
+			typ:     EventTaskUpdated,
+			payload: `{"boardId":1,"boardOwnerId":7,"taskId":3,"title":"Docs","assigneeId":7}`,
+			users:   []int64{7},
+			message: `task "Docs" updated`,
+		},
+		{
+			name:    "moved: no assignee",
+			typ:     EventTaskMoved,
+			payload: `{"boardId":1,"boardOwnerId":7,"taskId":3,"title":"Docs","assigneeId":0}`,
+			users:   []int64{7},
+			message: `task "Docs" moved`,
+		},
+		{
+			name:    "events without notifications",
+			typ:     "ColumnCreated",
+			payload: `{"boardId":1}`,
+		},
+		{
+			name:    "task deleted is not notified",
+			typ:     "TaskDeleted",
+			payload: `{"boardId":1,"boardOwnerId":7,"taskId":3,"title":"Docs","assigneeId":8}`,
+		},
+		{
+			name:    "broken payload",
+			typ:     EventTaskCreated,
+			payload: `{"boardId":`,
+			err:     ErrMalformedEvent,
+		},
+		{
+			name:    "invalid event id",
+			id:      "42",
+			typ:     EventTaskCreated,
+			payload: `{}`,
+			err:     ErrMalformedEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := tt.id
+			if id == "" {
+				id = eventID
+			}
+
+			notifications, err := FromEvent(Event{ID: id, Type: tt.typ, BoardID: 1, Payload: []byte(tt.payload)})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			if len(notifications) != len(tt.users) {
+				t.Fatalf("notifications = %d, want %d", len(notifications), len(tt.users))
+			}
+			for i, n := range notifications {
+				if n.UserID != tt.users[i] || n.EventID != id || n.BoardID != 1 || n.TaskID != 3 || n.EventType != tt.typ || n.Message != tt.message {
+					t.Errorf("notification %d = %+v", i, n)
+				}
+			}
+		})
+	}
+}
+
+func TestNewNotificationRequiresUser(t *testing.T) {
+	if _, err := NewNotification(Event{ID: eventID}, 0, 1, "message"); !errors.Is(err, ErrEmptyUser) {
+		t.Fatalf("err = %v, want ErrEmptyUser", err)
+	}
+}
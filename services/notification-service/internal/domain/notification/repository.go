@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+)
+
+type Repository interface {
+	// Create сохраняет уведомление; повтор для той же пары (событие, пользователь) игнорируется
+	Create(ctx context.Context, n *Notification) error
+
+	// ListByUser возвращает уведомления пользователя с id < beforeID (0 — без ограничения), от новых к старым
+	ListByUser(ctx context.Context, userID, beforeID int64, limit int) ([]*Notification, error)
+}
+
+// ProcessedEventRepository — журнал обработанных событий
+type ProcessedEventRepository interface {
+	// MarkProcessed отмечает событие обработанным. false — событие уже встречалось раньше.
+	MarkProcessed(ctx context.Context, e Event) (bool, error)
+}
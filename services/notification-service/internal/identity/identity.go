@@ -0,0 +1,22 @@
+// Package identity переносит id аутентифицированного пользователя через context.
+// gRPC транспорт кладет его туда из metadata, дальше он берется только отсюда,
+// а не из тела запроса.
+package identity
+
+import "context"
+
+// MetadataKey — ключ gRPC metadata, в котором API Gateway передает id пользователя
+// из проверенного JWT. gRPC Notification Service внутренний и доверяет шлюзу.
+const MetadataKey = "x-user-id"
+
+type userIDKey struct{}
+
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID возвращает id пользователя, если запрос аутентифицирован
+func UserID(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(int64)
+	return userID, ok && userID > 0
+}
@@ -0,0 +1,118 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/segmentio/kafka-go"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+// EventHandler — обработчик события доски (usecase.HandleEventUseCase)
+type EventHandler interface {
+	Handle(ctx context.Context, event notification.Event) error
+}
+
+// envelope — формат сообщения, который публикует outbox relay Board Service
+type envelope struct {
+	EventID    string          `json:"eventId"`
+	EventType  string          `json:"eventType"`
+	BoardID    int64           `json:"boardId"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// Consumer читает события досок в составе consumer group.
+//
+// Offset коммитится только после успешной обработки, так что доставка at-least-once,
+// а дубли отсекает сам обработчик по id события. Временные ошибки повторяются
+// на месте, не переходя к следующему сообщению: так сохраняется порядок событий доски.
+// Сообщения, которые невозможно разобрать, логируются и пропускаются.
+type Consumer struct {
+	reader       *kafka.Reader
+	handler      EventHandler
+	retryBackoff time.Duration
+}
+
+func NewConsumer(brokers []string, topic, groupID string, handler EventHandler, retryBackoff time.Duration) *Consumer {
+	return &Consumer{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: groupID,
+		}),
+		handler:      handler,
+		retryBackoff: retryBackoff,
+	}
+}
+
+// Run читает сообщения до отмены ctx
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error().Err(err).Msg("failed to fetch message from kafka")
+			continue
+		}
+
+		if !c.process(ctx, msg) {
+			return
+		}
+
+		if err := c.reader.CommitMessages(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Без коммита сообщение придет повторно, обработчик это переживет
+			log.Error().Err(err).Int64("offset", msg.Offset).Msg("failed to commit kafka offset")
+		}
+	}
+}
+
+// process возвращает false, только если ctx отменили до успешной обработки
+func (c *Consumer) process(ctx context.Context, msg kafka.Message) bool {
+	var env envelope
+	if err := json.Unmarshal(msg.Value, &env); err != nil {
+		log.Error().Err(err).Int64("offset", msg.Offset).Msg("skipping malformed kafka message")
+		return true
+	}
+
+	event := notification.Event{
+		ID:         env.EventID,
+		Type:       env.EventType,
+		BoardID:    env.BoardID,
+		OccurredAt: env.OccurredAt,
+		Payload:    env.Payload,
+	}
+
+	for {
+		err := c.handler.Handle(ctx, event)
+		if err == nil {
+			return true
+		}
+
+		if errors.Is(err, notification.ErrMalformedEvent) {
+			log.Error().Err(err).Str("event_id", event.ID).Msg("skipping malformed event")
+			return true
+		}
+
+		log.Warn().Err(err).Str("event_id", event.ID).Msg("failed to handle event, retrying")
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(c.retryBackoff):
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	return c.reader.Close()
+}
@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+// scriptedHandler возвращает ошибки из errs по очереди, потом nil
+type scriptedHandler struct {
+	errs   []error
+	calls  int
+	events []notification.Event
+}
+
+func (h *scriptedHandler) Handle(ctx context.Context, event notification.Event) error {
+	h.calls++
+	h.events = append(h.events, event)
+	if len(h.errs) == 0 {
+		return nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return err
+}
+
+const message = `{"eventId":"0b9f6a3e-1c2d-4e5f-8a9b-0c1d2e3f4a5b","eventType":"TaskCreated","boardId":4,"occurredAt":"2026-01-02T03:04:05Z","payload":{"taskId":1}}`
+
+func TestConsumerProcess(t *testing.T) {
+	errTemporary := errors.New("database is down")
+
+	tests := []struct {
+		name    string
+		value   string
+		errs    []error
+		cancel  bool
+		handled bool
+		calls   int
+	}{
+		{name: "handled", value: message, handled: true, calls: 1},
+		{name: "temporary errors are retried", value: message, errs: []error{errTemporary, errTemporary}, handled: true, calls: 3},
+		{name: "malformed event is skipped", value: message, errs: []error{notification.ErrMalformedEvent}, handled: true, calls: 1},
+		{name: "malformed message is skipped", value: `{"eventId":`, handled: true, calls: 0},
+		{name: "cancelled while retrying", value: message, errs: []error{errTemporary}, cancel: true, handled: false, calls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &scriptedHandler{errs: tt.errs}
+			c := &Consumer{handler: handler, retryBackoff: time.Millisecond}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			if handled := c.process(ctx, kafka.Message{Value: []byte(tt.value)}); handled != tt.handled {
+				t.Fatalf("process = %v, want %v", handled, tt.handled)
+			}
+			if handler.calls != tt.calls {
+				t.Fatalf("handler calls = %d, want %d", handler.calls, tt.calls)
+			}
+			if tt.calls == 0 {
+				return
+			}
+
+			e := handler.events[0]
+			if e.ID != "0b9f6a3e-1c2d-4e5f-8a9b-0c1d2e3f4a5b" || e.Type != "TaskCreated" || e.BoardID != 4 || string(e.Payload) != `{"taskId":1}` {
+				t.Errorf("event = %+v", e)
+			}
+			if !e.OccurredAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+				t.Errorf("occurred at = %v", e.OccurredAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+	usecase "Taskify/services/notification-service/internal/usecase/notification"
+)
+
+var _ usecase.Sender = (*LogSender)(nil)
+
+// LogSender имитирует отправку письма записью в лог
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(_ context.Context, n *notification.Notification) error {
+	log.Info().
+		Str("event_id", n.EventID).
+		Int64("board_id", n.BoardID).
+		Msgf("email sent to user %d: %s", n.UserID, n.Message)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"database/sql"
+	"time"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+type NotificationModel struct {
+	ID        int64         `db:"id"`
+	EventID   string        `db:"event_id"`
+	UserID    int64         `db:"user_id"`
+	BoardID   int64         `db:"board_id"`
+	TaskID    sql.NullInt64 `db:"task_id"`
+	EventType string        `db:"event_type"`
+	Message   string        `db:"message"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+func (m *NotificationModel) toDomain() *notification.Notification {
+	return &notification.Notification{
+		ID:        m.ID,
+		EventID:   m.EventID,
+		UserID:    m.UserID,
+		BoardID:   m.BoardID,
+		TaskID:    m.TaskID.Int64,
+		EventType: m.EventType,
+		Message:   m.Message,
+		CreatedAt: m.CreatedAt,
+	}
+}
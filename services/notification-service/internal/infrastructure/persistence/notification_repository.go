@@ -0,0 +1,83 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ notification.Repository = (*NotificationRepository)(nil)
+
+type NotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	query := `INSERT INTO notifications(event_id, user_id, board_id, task_id, event_type, message, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (event_id, user_id) DO NOTHING
+		RETURNING id`
+
+	taskID := sql.NullInt64{Int64: n.TaskID, Valid: n.TaskID != 0}
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, n.EventID, n.UserID, n.BoardID, taskID, n.EventType, n.Message, n.CreatedAt).Scan(&n.ID)
+	if err != nil {
+		// Уведомление об этом событии пользователю уже записано
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID, beforeID int64, limit int) ([]*notification.Notification, error) {
+	query := `SELECT id, event_id::text, user_id, board_id, task_id, event_type, message, created_at
+		FROM notifications
+		WHERE user_id = $1 AND ($2::bigint = 0 OR id < $2::bigint)
+		ORDER BY id DESC
+		LIMIT $3`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]*notification.Notification, 0)
+
+	for rows.Next() {
+		var model NotificationModel
+		if err := rows.Scan(
+			&model.ID,
+			&model.EventID,
+			&model.UserID,
+			&model.BoardID,
+			&model.TaskID,
+			&model.EventType,
+			&model.Message,
+			&model.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+
+		notifications = append(notifications, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return notifications, nil
+}
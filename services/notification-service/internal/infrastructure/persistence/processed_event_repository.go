@@ -0,0 +1,33 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ notification.ProcessedEventRepository = (*ProcessedEventRepository)(nil)
+
+type ProcessedEventRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProcessedEventRepository(db *pgxpool.Pool) *ProcessedEventRepository {
+	return &ProcessedEventRepository{db: db}
+}
+
+// MarkProcessed опирается на первичный ключ: параллельная обработка того же события
+// (например, при ребалансировке группы) ждет коммита первой и получает false
+func (r *ProcessedEventRepository) MarkProcessed(ctx context.Context, e notification.Event) (bool, error) {
+	query := "INSERT INTO processed_events(event_id, event_type) VALUES ($1, $2) ON CONFLICT (event_id) DO NOTHING"
+
+	commandTag, err := conn(ctx, r.db).Exec(ctx, query, e.ID, e.Type)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+
+	return commandTag.RowsAffected() == 1, nil
+}
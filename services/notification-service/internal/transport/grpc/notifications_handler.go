@@ -0,0 +1,74 @@
+package grpc_handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "Taskify/proto/notifications/v1"
+
+	domain "Taskify/services/notification-service/internal/domain/notification"
+	usecase "Taskify/services/notification-service/internal/usecase/notification"
+)
+
+// Handler реализует NotificationServiceServer
+type Handler struct {
+	pb.UnimplementedNotificationServiceServer
+
+	listNotificationsUC *usecase.ListNotificationsUseCase
+}
+
+func NewHandler(listNotificationsUC *usecase.ListNotificationsUseCase) *Handler {
+	return &Handler{listNotificationsUC: listNotificationsUC}
+}
+
+func toProtoNotification(n *domain.Notification) *pb.Notification {
+	return &pb.Notification{
+		Id:        n.ID,
+		EventId:   n.EventID,
+		UserId:    n.UserID,
+		BoardId:   n.BoardID,
+		TaskId:    n.TaskID,
+		EventType: n.EventType,
+		Message:   n.Message,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+	}
+}
+
+// ListNotifications отдает историю только самому пользователю: user_id запроса
+// должен совпадать с id из metadata, иначе любой клиент читал бы чужие уведомления
+func (h *Handler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId != userID {
+		return nil, status.Error(codes.PermissionDenied, "notifications of another user")
+	}
+
+	notifications, nextBeforeID, err := h.listNotificationsUC.Handle(ctx, usecase.ListNotificationsQuery{
+		UserID:   userID,
+		Limit:    int(req.Limit),
+		BeforeID: req.BeforeId,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrEmptyUser) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+
+	protoNotifications := make([]*pb.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		protoNotifications = append(protoNotifications, toProtoNotification(n))
+	}
+
+	return &pb.ListNotificationsResponse{
+		Notifications: protoNotifications,
+		NextBeforeId:  nextBeforeID,
+	}, nil
+}
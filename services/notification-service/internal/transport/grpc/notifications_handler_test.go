@@ -0,0 +1,76 @@
+package grpc_handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "Taskify/proto/notifications/v1"
+
+	domain "Taskify/services/notification-service/internal/domain/notification"
+	"Taskify/services/notification-service/internal/identity"
+	usecase "Taskify/services/notification-service/internal/usecase/notification"
+)
+
+// userRepo отдает по одному уведомлению на пользователя
+type userRepo struct{}
+
+func (userRepo) Create(ctx context.Context, n *domain.Notification) error { return nil }
+
+func (userRepo) ListByUser(ctx context.Context, userID, beforeID int64, limit int) ([]*domain.Notification, error) {
+	return []*domain.Notification{{ID: 1, UserID: userID, Message: "hello"}}, nil
+}
+
+// call вызывает ListNotifications через IdentityInterceptor, как это делает gRPC сервер
+func call(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	h := NewHandler(usecase.NewListNotificationsUseCase(userRepo{}))
+
+	resp, err := IdentityInterceptor()(ctx, req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return h.ListNotifications(ctx, req.(*pb.ListNotificationsRequest))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.ListNotificationsResponse), nil
+}
+
+func withMetadata(userID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(identity.MetadataKey, userID))
+}
+
+func TestListNotificationsIdentity(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		userID int64
+		code   codes.Code
+	}{
+		{name: "own notifications", ctx: withMetadata("7"), userID: 7, code: codes.OK},
+		{name: "no metadata", ctx: context.Background(), userID: 7, code: codes.Unauthenticated},
+		{name: "metadata without user", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "1")), userID: 7, code: codes.Unauthenticated},
+		{name: "malformed user id", ctx: withMetadata("abc"), userID: 7, code: codes.Unauthenticated},
+		{name: "non-positive user id", ctx: withMetadata("0"), userID: 7, code: codes.Unauthenticated},
+		{name: "another user", ctx: withMetadata("7"), userID: 8, code: codes.PermissionDenied},
+		{name: "empty user in request", ctx: withMetadata("7"), userID: 0, code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := call(tt.ctx, &pb.ListNotificationsRequest{UserId: tt.userID})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", code, tt.code, err)
+			}
+
+			if tt.code != codes.OK {
+				return
+			}
+			if len(resp.Notifications) != 1 || resp.Notifications[0].UserId != tt.userID {
+				t.Fatalf("notifications = %v, want one for user %d", resp.Notifications, tt.userID)
+			}
+		})
+	}
+}
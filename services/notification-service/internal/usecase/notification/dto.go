@@ -0,0 +1,9 @@
+package notification
+
+type ListNotificationsQuery struct {
+	UserID int64
+	// 0 — DefaultListLimit
+	Limit int
+	// Курсор: id последнего уведомления предыдущей страницы, 0 — первая страница
+	BeforeID int64
+}
@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+type HandleEventUseCase struct {
+	tx            TxManager
+	repo          notification.Repository
+	processedRepo notification.ProcessedEventRepository
+	sender        Sender
+}
+
+func NewHandleEventUseCase(tx TxManager, repo notification.Repository, processedRepo notification.ProcessedEventRepository, sender Sender) *HandleEventUseCase {
+	return &HandleEventUseCase{tx: tx, repo: repo, processedRepo: processedRepo, sender: sender}
+}
+
+// Handle обрабатывает событие доски ровно один раз по его id.
+// Отметка об обработке и история пишутся в одной транзакции: повторная доставка
+// того же события (Kafka гарантирует at-least-once) ничего не меняет. Если транзакция
+// откатилась уже после Send, письмо при повторе уйдет еще раз — для уведомлений
+// дубль лучше, чем потеря.
+func (uc *HandleEventUseCase) Handle(ctx context.Context, event notification.Event) error {
+	notifications, err := notification.FromEvent(event)
+	if err != nil {
+		return err
+	}
+
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		fresh, err := uc.processedRepo.MarkProcessed(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		if !fresh {
+			log.Debug().Str("event_id", event.ID).Msg("event already processed, skipping")
+			return nil
+		}
+
+		for _, n := range notifications {
+			if err := uc.repo.Create(ctx, n); err != nil {
+				return err
+			}
+
+			if err := uc.sender.Send(ctx, n); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
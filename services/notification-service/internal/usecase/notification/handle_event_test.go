@@ -0,0 +1,173 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+// memoryStore хранит уведомления и журнал событий; транзакция откатывает оба
+type memoryStore struct {
+	notifications []notification.Notification
+	processed     map[string]bool
+	sent          []int64
+	// sendErr возвращается из Send, если задан
+	sendErr error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{processed: make(map[string]bool)}
+}
+
+func (s *memoryStore) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	notifications, processed := slices.Clone(s.notifications), maps.Clone(s.processed)
+	if err := fn(ctx); err != nil {
+		s.notifications, s.processed = notifications, processed
+		return err
+	}
+	return nil
+}
+
+func (s *memoryStore) Create(ctx context.Context, n *notification.Notification) error {
+	n.ID = int64(len(s.notifications) + 1)
+	s.notifications = append(s.notifications, *n)
+	return nil
+}
+
+// ListByUser отдает уведомления пользователя от новых к старым
+func (s *memoryStore) ListByUser(ctx context.Context, userID, beforeID int64, limit int) ([]*notification.Notification, error) {
+	var list []*notification.Notification
+	for i := len(s.notifications) - 1; i >= 0 && len(list) < limit; i-- {
+		n := s.notifications[i]
+		if n.UserID == userID && (beforeID == 0 || n.ID < beforeID) {
+			list = append(list, &n)
+		}
+	}
+	return list, nil
+}
+
+func (s *memoryStore) MarkProcessed(ctx context.Context, e notification.Event) (bool, error) {
+	if s.processed[e.ID] {
+		return false, nil
+	}
+	s.processed[e.ID] = true
+	return true, nil
+}
+
+func (s *memoryStore) Send(ctx context.Context, n *notification.Notification) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, n.UserID)
+	return nil
+}
+
+func taskCreated(id string) notification.Event {
+	return notification.Event{
+		ID:      id,
+		Type:    notification.EventTaskCreated,
+		BoardID: 1,
+		Payload: []byte(`{"boardId":1,"boardOwnerId":7,"taskId":3,"title":"Docs","assigneeId":8}`),
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	const (
+		first  = "0b9f6a3e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"
+		second = "1c0a7b4f-2d3e-4f5a-9b0c-1d2e3f4a5b6c"
+	)
+	errSend := errors.New("smtp is down")
+
+	tests := []struct {
+		name    string
+		events  []notification.Event
+		sendErr error
+		err     error
+		stored  int
+		sent    []int64
+	}{
+		{name: "one event", events: []notification.Event{taskCreated(first)}, stored: 2, sent: []int64{8, 7}},
+		{name: "redelivery is ignored", events: []notification.Event{taskCreated(first), taskCreated(first)}, stored: 2, sent: []int64{8, 7}},
+		{name: "two events", events: []notification.Event{taskCreated(first), taskCreated(second)}, stored: 4, sent: []int64{8, 7, 8, 7}},
+		{name: "event without notifications", events: []notification.Event{{ID: first, Type: "ColumnCreated", Payload: []byte(`{}`)}}},
+		{name: "malformed event", events: []notification.Event{{ID: "x", Type: notification.EventTaskCreated}}, err: notification.ErrMalformedEvent},
+		// Неудачная отправка откатывает и историю, и отметку: событие обработается при повторе
+		{name: "send failure", events: []notification.Event{taskCreated(first)}, sendErr: errSend, err: errSend},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			store.sendErr = tt.sendErr
+			uc := NewHandleEventUseCase(store, store, store, store)
+
+			var err error
+			for _, e := range tt.events {
+				if err = uc.Handle(context.Background(), e); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			if len(store.notifications) != tt.stored {
+				t.Errorf("stored = %d, want %d", len(store.notifications), tt.stored)
+			}
+			if !slices.Equal(store.sent, tt.sent) {
+				t.Errorf("sent to %v, want %v", store.sent, tt.sent)
+			}
+			if tt.sendErr != nil && store.processed[first] {
+				t.Errorf("event is marked processed after a failed send")
+			}
+		})
+	}
+}
+
+func TestListNotifications(t *testing.T) {
+	store := newMemoryStore()
+	for range 25 {
+		_ = store.Create(context.Background(), &notification.Notification{UserID: 7})
+	}
+	_ = store.Create(context.Background(), &notification.Notification{UserID: 8})
+
+	tests := []struct {
+		name  string
+		query ListNotificationsQuery
+		count int
+		first int64
+		next  int64
+		err   error
+	}{
+		{name: "default limit", query: ListNotificationsQuery{UserID: 7}, count: 20, first: 25, next: 6},
+		{name: "next page", query: ListNotificationsQuery{UserID: 7, BeforeID: 6}, count: 5, first: 5, next: 0},
+		{name: "custom limit", query: ListNotificationsQuery{UserID: 7, Limit: 5}, count: 5, first: 25, next: 21},
+		{name: "limit above maximum", query: ListNotificationsQuery{UserID: 7, Limit: 1000}, count: 25, first: 25, next: 0},
+		{name: "other user", query: ListNotificationsQuery{UserID: 8}, count: 1, first: 26, next: 0},
+		{name: "empty user", query: ListNotificationsQuery{}, err: notification.ErrEmptyUser},
+	}
+
+	uc := NewListNotificationsUseCase(store)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, next, err := uc.Handle(context.Background(), tt.query)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if len(list) != tt.count || list[0].ID != tt.first {
+				t.Fatalf("got %d notifications starting at %d, want %d starting at %d", len(list), list[0].ID, tt.count, tt.first)
+			}
+			if next != tt.next {
+				t.Errorf("next = %d, want %d", next, tt.next)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+type ListNotificationsUseCase struct {
+	repo notification.Repository
+}
+
+func NewListNotificationsUseCase(repo notification.Repository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{repo: repo}
+}
+
+// Handle возвращает страницу уведомлений и курсор следующей (0, если страниц больше нет)
+func (uc *ListNotificationsUseCase) Handle(ctx context.Context, query ListNotificationsQuery) ([]*notification.Notification, int64, error) {
+	if query.UserID == 0 {
+		return nil, 0, notification.ErrEmptyUser
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	notifications, err := uc.repo.ListByUser(ctx, query.UserID, query.BeforeID, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var nextBeforeID int64
+	if len(notifications) == limit {
+		nextBeforeID = notifications[len(notifications)-1].ID
+	}
+
+	return notifications, nextBeforeID, nil
+}
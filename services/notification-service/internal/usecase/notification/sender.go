@@ -0,0 +1,13 @@
+package notification
+
+import (
+	"context"
+
+	"Taskify/services/notification-service/internal/domain/notification"
+)
+
+// Sender доставляет уведомление пользователю. Пока письма не отправляются
+// по-настоящему, реализация только пишет их в лог (mailer.LogSender).
+type Sender interface {
+	Send(ctx context.Context, n *notification.Notification) error
+}